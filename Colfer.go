@@ -347,3 +347,3139 @@ func (o *ColferA) UnmarshalBinary(data []byte) error {
 	}
 	return nil
 }
+
+type ColferPerson struct {
+	Name     string
+	BirthDay time.Time
+	Phone    string
+	Money    float64
+	Address  *ColferAddress
+	Children []*ColferChild
+}
+
+// MarshalTo encodes o as Colfer into buf and returns the number of bytes written.
+// If the buffer is too small, MarshalTo will panic.
+func (o *ColferPerson) MarshalTo(buf []byte) int {
+	if o == nil {
+		return 0
+	}
+
+	buf[0] = 0x80
+	i := 1
+
+	if v := o.Name; len(v) != 0 {
+		buf[i] = 0
+		i++
+		x := uint(len(v))
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+		to := i + len(v)
+		copy(buf[i:], v)
+		i = to
+	}
+
+	if v := o.BirthDay; !v.IsZero() {
+		buf[i] = 1
+		s, ns := v.Unix(), v.Nanosecond()
+		buf[i+1], buf[i+2], buf[i+3], buf[i+4] = byte(s>>56), byte(s>>48), byte(s>>40), byte(s>>32)
+		buf[i+5], buf[i+6], buf[i+7], buf[i+8] = byte(s>>24), byte(s>>16), byte(s>>8), byte(s)
+		if ns == 0 {
+			i += 9
+		} else {
+			buf[i] |= 0x80
+			buf[i+9], buf[i+10], buf[i+11], buf[i+12] = byte(ns>>24), byte(ns>>16), byte(ns>>8), byte(ns)
+			i += 13
+		}
+	}
+
+	if v := o.Phone; len(v) != 0 {
+		buf[i] = 2
+		i++
+		x := uint(len(v))
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+		to := i + len(v)
+		copy(buf[i:], v)
+		i = to
+	}
+
+	if v := o.Money; v != 0.0 {
+		buf[i] = 3
+		x := math.Float64bits(v)
+		buf[i+1], buf[i+2], buf[i+3], buf[i+4] = byte(x>>56), byte(x>>48), byte(x>>40), byte(x>>32)
+		buf[i+5], buf[i+6], buf[i+7], buf[i+8] = byte(x>>24), byte(x>>16), byte(x>>8), byte(x)
+		i += 9
+	}
+
+	if v := o.Address; v != nil {
+		buf[i] = 4
+		i++
+		i += v.MarshalTo(buf[i:])
+	}
+
+	if l := len(o.Children); l != 0 {
+		buf[i] = 5
+		i++
+		x := uint(l)
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+		for _, v := range o.Children {
+			i += v.MarshalTo(buf[i:])
+		}
+	}
+
+	buf[i] = 0x7f
+	i++
+	return i
+}
+
+// MarshalLen returns the Colfer serial byte size.
+func (o *ColferPerson) MarshalLen() int {
+	if o == nil {
+		return 0
+	}
+
+	l := 2
+
+	if x := len(o.Name); x != 0 {
+		l += x
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	if v := o.BirthDay; !v.IsZero() {
+		if v.Nanosecond() == 0 {
+			l += 9
+		} else {
+			l += 13
+		}
+	}
+
+	if x := len(o.Phone); x != 0 {
+		l += x
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	if o.Money != 0.0 {
+		l += 9
+	}
+
+	if v := o.Address; v != nil {
+		l += v.MarshalLen() + 1
+	}
+
+	if x := len(o.Children); x != 0 {
+		for _, v := range o.Children {
+			l += v.MarshalLen()
+		}
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	return l
+}
+
+// MarshalBinary encodes o as Colfer conform encoding.BinaryMarshaler.
+// The error return is always nil.
+func (o *ColferPerson) MarshalBinary() (data []byte, err error) {
+	data = make([]byte, o.MarshalLen())
+	o.MarshalTo(data)
+	return data, nil
+}
+
+// UnmarshalBinary decodes data as Colfer conform encoding.BinaryUnmarshaler.
+// The error return options are io.EOF, goserbench.ColferError, and goserbench.ColferContinue.
+func (o *ColferPerson) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return io.EOF
+	}
+	if data[0] != 0x80 {
+		return ColferError(0)
+	}
+
+	if len(data) == 1 {
+		return io.EOF
+	}
+	header := data[1]
+	i := 2
+
+	if header == 0 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+
+		to := i + int(x)
+		if to >= len(data) {
+			return io.EOF
+		}
+		o.Name = string(data[i:to])
+
+		header = data[to]
+		i = to + 1
+	}
+
+	if header == 1 {
+		if i+8 >= len(data) {
+			return io.EOF
+		}
+		sec := uint64(data[i])<<56 | uint64(data[i+1])<<48 | uint64(data[i+2])<<40 | uint64(data[i+3])<<32
+		sec |= uint64(data[i+4])<<24 | uint64(data[i+5])<<16 | uint64(data[i+6])<<8 | uint64(data[i+7])
+		o.BirthDay = time.Unix(int64(sec), 0)
+
+		header = data[i+8]
+		i += 9
+	} else if header == 1|0x80 {
+		if i+12 >= len(data) {
+			return io.EOF
+		}
+		sec := uint64(data[i])<<56 | uint64(data[i+1])<<48 | uint64(data[i+2])<<40 | uint64(data[i+3])<<32
+		sec |= uint64(data[i+4])<<24 | uint64(data[i+5])<<16 | uint64(data[i+6])<<8 | uint64(data[i+7])
+		nsec := int64(uint(data[i+8])<<24 | uint(data[i+9])<<16 | uint(data[i+10])<<8 | uint(data[i+11]))
+		o.BirthDay = time.Unix(int64(sec), nsec)
+
+		header = data[i+12]
+		i += 13
+	}
+
+	if header == 2 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+
+		to := i + int(x)
+		if to >= len(data) {
+			return io.EOF
+		}
+		o.Phone = string(data[i:to])
+
+		header = data[to]
+		i = to + 1
+	}
+
+	if header == 3 {
+		if i+8 >= len(data) {
+			return io.EOF
+		}
+		x := uint64(data[i])<<56 | uint64(data[i+1])<<48 | uint64(data[i+2])<<40 | uint64(data[i+3])<<32
+		x |= uint64(data[i+4])<<24 | uint64(data[i+5])<<16 | uint64(data[i+6])<<8 | uint64(data[i+7])
+		o.Money = math.Float64frombits(x)
+
+		header = data[i+8]
+		i += 9
+	}
+
+	if header == 4 {
+		o.Address = new(ColferAddress)
+		err := o.Address.UnmarshalBinary(data[i:])
+		cont, ok := err.(ColferContinue)
+		if !ok {
+			if err == nil {
+				err = io.EOF
+			}
+			return err
+		}
+		i += int(cont)
+
+		header = data[i]
+		i++
+	}
+
+	if header == 5 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+
+		a := make([]ColferChild, int(x))
+		o.Children = make([]*ColferChild, int(x))
+		for ai := range a {
+			v := &a[ai]
+			err := v.UnmarshalBinary(data[i:])
+			cont, ok := err.(ColferContinue)
+			if !ok {
+				if err == nil {
+					err = io.EOF
+				}
+				return err
+			}
+			i += int(cont)
+			o.Children[ai] = v
+		}
+
+		if i == len(data) {
+			return io.EOF
+		}
+		header = data[i]
+		i++
+	}
+
+	if header != 0x7f {
+		return ColferError(i - 1)
+	}
+	if i != len(data) {
+		return ColferContinue(i)
+	}
+	return nil
+}
+
+type ColferAddress struct {
+	Street  string
+	City    string
+	Zip     string
+	Country string
+}
+
+// MarshalTo encodes o as Colfer into buf and returns the number of bytes written.
+// If the buffer is too small, MarshalTo will panic.
+func (o *ColferAddress) MarshalTo(buf []byte) int {
+	if o == nil {
+		return 0
+	}
+
+	buf[0] = 0x80
+	i := 1
+
+	if v := o.Street; len(v) != 0 {
+		buf[i] = 0
+		i++
+		x := uint(len(v))
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+		to := i + len(v)
+		copy(buf[i:], v)
+		i = to
+	}
+
+	if v := o.City; len(v) != 0 {
+		buf[i] = 1
+		i++
+		x := uint(len(v))
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+		to := i + len(v)
+		copy(buf[i:], v)
+		i = to
+	}
+
+	if v := o.Zip; len(v) != 0 {
+		buf[i] = 2
+		i++
+		x := uint(len(v))
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+		to := i + len(v)
+		copy(buf[i:], v)
+		i = to
+	}
+
+	if v := o.Country; len(v) != 0 {
+		buf[i] = 3
+		i++
+		x := uint(len(v))
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+		to := i + len(v)
+		copy(buf[i:], v)
+		i = to
+	}
+
+	buf[i] = 0x7f
+	i++
+	return i
+}
+
+// MarshalLen returns the Colfer serial byte size.
+func (o *ColferAddress) MarshalLen() int {
+	if o == nil {
+		return 0
+	}
+
+	l := 2
+
+	if x := len(o.Street); x != 0 {
+		l += x
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	if x := len(o.City); x != 0 {
+		l += x
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	if x := len(o.Zip); x != 0 {
+		l += x
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	if x := len(o.Country); x != 0 {
+		l += x
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	return l
+}
+
+// MarshalBinary encodes o as Colfer conform encoding.BinaryMarshaler.
+// The error return is always nil.
+func (o *ColferAddress) MarshalBinary() (data []byte, err error) {
+	data = make([]byte, o.MarshalLen())
+	o.MarshalTo(data)
+	return data, nil
+}
+
+// UnmarshalBinary decodes data as Colfer conform encoding.BinaryUnmarshaler.
+// The error return options are io.EOF, goserbench.ColferError, and goserbench.ColferContinue.
+func (o *ColferAddress) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return io.EOF
+	}
+	if data[0] != 0x80 {
+		return ColferError(0)
+	}
+
+	if len(data) == 1 {
+		return io.EOF
+	}
+	header := data[1]
+	i := 2
+
+	if header == 0 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+
+		to := i + int(x)
+		if to >= len(data) {
+			return io.EOF
+		}
+		o.Street = string(data[i:to])
+
+		header = data[to]
+		i = to + 1
+	}
+
+	if header == 1 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+
+		to := i + int(x)
+		if to >= len(data) {
+			return io.EOF
+		}
+		o.City = string(data[i:to])
+
+		header = data[to]
+		i = to + 1
+	}
+
+	if header == 2 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+
+		to := i + int(x)
+		if to >= len(data) {
+			return io.EOF
+		}
+		o.Zip = string(data[i:to])
+
+		header = data[to]
+		i = to + 1
+	}
+
+	if header == 3 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+
+		to := i + int(x)
+		if to >= len(data) {
+			return io.EOF
+		}
+		o.Country = string(data[i:to])
+
+		header = data[to]
+		i = to + 1
+	}
+
+	if header != 0x7f {
+		return ColferError(i - 1)
+	}
+	if i != len(data) {
+		return ColferContinue(i)
+	}
+	return nil
+}
+
+type ColferChild struct {
+	Name     string
+	BirthDay time.Time
+	Grade    int32
+}
+
+// MarshalTo encodes o as Colfer into buf and returns the number of bytes written.
+// If the buffer is too small, MarshalTo will panic.
+func (o *ColferChild) MarshalTo(buf []byte) int {
+	if o == nil {
+		return 0
+	}
+
+	buf[0] = 0x80
+	i := 1
+
+	if v := o.Name; len(v) != 0 {
+		buf[i] = 0
+		i++
+		x := uint(len(v))
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+		to := i + len(v)
+		copy(buf[i:], v)
+		i = to
+	}
+
+	if v := o.BirthDay; !v.IsZero() {
+		buf[i] = 1
+		s, ns := v.Unix(), v.Nanosecond()
+		buf[i+1], buf[i+2], buf[i+3], buf[i+4] = byte(s>>56), byte(s>>48), byte(s>>40), byte(s>>32)
+		buf[i+5], buf[i+6], buf[i+7], buf[i+8] = byte(s>>24), byte(s>>16), byte(s>>8), byte(s)
+		if ns == 0 {
+			i += 9
+		} else {
+			buf[i] |= 0x80
+			buf[i+9], buf[i+10], buf[i+11], buf[i+12] = byte(ns>>24), byte(ns>>16), byte(ns>>8), byte(ns)
+			i += 13
+		}
+	}
+
+	if v := o.Grade; v != 0 {
+		x := uint32(v)
+		if v >= 0 {
+			buf[i] = 2
+		} else {
+			x = ^x + 1
+			buf[i] = 2 | 0x80
+		}
+		i++
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+	}
+
+	buf[i] = 0x7f
+	i++
+	return i
+}
+
+// MarshalLen returns the Colfer serial byte size.
+func (o *ColferChild) MarshalLen() int {
+	if o == nil {
+		return 0
+	}
+
+	l := 2
+
+	if x := len(o.Name); x != 0 {
+		l += x
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	if v := o.BirthDay; !v.IsZero() {
+		if v.Nanosecond() == 0 {
+			l += 9
+		} else {
+			l += 13
+		}
+	}
+
+	if v := o.Grade; v != 0 {
+		x := uint32(v)
+		if v < 0 {
+			x = ^x + 1
+		}
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	return l
+}
+
+// MarshalBinary encodes o as Colfer conform encoding.BinaryMarshaler.
+// The error return is always nil.
+func (o *ColferChild) MarshalBinary() (data []byte, err error) {
+	data = make([]byte, o.MarshalLen())
+	o.MarshalTo(data)
+	return data, nil
+}
+
+// UnmarshalBinary decodes data as Colfer conform encoding.BinaryUnmarshaler.
+// The error return options are io.EOF, goserbench.ColferError, and goserbench.ColferContinue.
+func (o *ColferChild) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return io.EOF
+	}
+	if data[0] != 0x80 {
+		return ColferError(0)
+	}
+
+	if len(data) == 1 {
+		return io.EOF
+	}
+	header := data[1]
+	i := 2
+
+	if header == 0 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+
+		to := i + int(x)
+		if to >= len(data) {
+			return io.EOF
+		}
+		o.Name = string(data[i:to])
+
+		header = data[to]
+		i = to + 1
+	}
+
+	if header == 1 {
+		if i+8 >= len(data) {
+			return io.EOF
+		}
+		sec := uint64(data[i])<<56 | uint64(data[i+1])<<48 | uint64(data[i+2])<<40 | uint64(data[i+3])<<32
+		sec |= uint64(data[i+4])<<24 | uint64(data[i+5])<<16 | uint64(data[i+6])<<8 | uint64(data[i+7])
+		o.BirthDay = time.Unix(int64(sec), 0)
+
+		header = data[i+8]
+		i += 9
+	} else if header == 1|0x80 {
+		if i+12 >= len(data) {
+			return io.EOF
+		}
+		sec := uint64(data[i])<<56 | uint64(data[i+1])<<48 | uint64(data[i+2])<<40 | uint64(data[i+3])<<32
+		sec |= uint64(data[i+4])<<24 | uint64(data[i+5])<<16 | uint64(data[i+6])<<8 | uint64(data[i+7])
+		nsec := int64(uint(data[i+8])<<24 | uint(data[i+9])<<16 | uint(data[i+10])<<8 | uint(data[i+11]))
+		o.BirthDay = time.Unix(int64(sec), nsec)
+
+		header = data[i+12]
+		i += 13
+	}
+
+	if header == 2 || header == 2|0x80 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		if header&0x80 != 0 {
+			x = ^x + 1
+		}
+		o.Grade = int32(x)
+
+		if i == len(data) {
+			return io.EOF
+		}
+		header = data[i]
+		i++
+	}
+
+	if header != 0x7f {
+		return ColferError(i - 1)
+	}
+	if i != len(data) {
+		return ColferContinue(i)
+	}
+	return nil
+}
+
+type ColferTaggedA struct {
+	Name     string
+	BirthDay time.Time
+	Phone    string
+	Siblings int32
+	Spouse   bool
+	Money    float64
+	Tags     []*ColferTag
+	Aliases  []string
+}
+
+// MarshalTo encodes o as Colfer into buf and returns the number of bytes written.
+// If the buffer is too small, MarshalTo will panic.
+func (o *ColferTaggedA) MarshalTo(buf []byte) int {
+	if o == nil {
+		return 0
+	}
+
+	buf[0] = 0x80
+	i := 1
+
+	if v := o.Name; len(v) != 0 {
+		buf[i] = 0
+		i++
+		x := uint(len(v))
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+		to := i + len(v)
+		copy(buf[i:], v)
+		i = to
+	}
+
+	if v := o.BirthDay; !v.IsZero() {
+		buf[i] = 1
+		s, ns := v.Unix(), v.Nanosecond()
+		buf[i+1], buf[i+2], buf[i+3], buf[i+4] = byte(s>>56), byte(s>>48), byte(s>>40), byte(s>>32)
+		buf[i+5], buf[i+6], buf[i+7], buf[i+8] = byte(s>>24), byte(s>>16), byte(s>>8), byte(s)
+		if ns == 0 {
+			i += 9
+		} else {
+			buf[i] |= 0x80
+			buf[i+9], buf[i+10], buf[i+11], buf[i+12] = byte(ns>>24), byte(ns>>16), byte(ns>>8), byte(ns)
+			i += 13
+		}
+	}
+
+	if v := o.Phone; len(v) != 0 {
+		buf[i] = 2
+		i++
+		x := uint(len(v))
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+		to := i + len(v)
+		copy(buf[i:], v)
+		i = to
+	}
+
+	if v := o.Siblings; v != 0 {
+		x := uint32(v)
+		if v >= 0 {
+			buf[i] = 3
+		} else {
+			x = ^x + 1
+			buf[i] = 3 | 0x80
+		}
+		i++
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+	}
+
+	if o.Spouse {
+		buf[i] = 4
+		i++
+	}
+
+	if v := o.Money; v != 0.0 {
+		buf[i] = 5
+		x := math.Float64bits(v)
+		buf[i+1], buf[i+2], buf[i+3], buf[i+4] = byte(x>>56), byte(x>>48), byte(x>>40), byte(x>>32)
+		buf[i+5], buf[i+6], buf[i+7], buf[i+8] = byte(x>>24), byte(x>>16), byte(x>>8), byte(x)
+		i += 9
+	}
+
+	if l := len(o.Tags); l != 0 {
+		buf[i] = 6
+		i++
+		x := uint(l)
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+		for _, v := range o.Tags {
+			i += v.MarshalTo(buf[i:])
+		}
+	}
+
+	if l := len(o.Aliases); l != 0 {
+		buf[i] = 7
+		i++
+		x := uint(l)
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+		for _, a := range o.Aliases {
+			x := uint(len(a))
+			for x >= 0x80 {
+				buf[i] = byte(x | 0x80)
+				x >>= 7
+				i++
+			}
+			buf[i] = byte(x)
+			i++
+			i += copy(buf[i:], a)
+		}
+	}
+
+	buf[i] = 0x7f
+	i++
+	return i
+}
+
+// MarshalLen returns the Colfer serial byte size.
+func (o *ColferTaggedA) MarshalLen() int {
+	if o == nil {
+		return 0
+	}
+
+	l := 2
+
+	if x := len(o.Name); x != 0 {
+		l += x
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	if v := o.BirthDay; !v.IsZero() {
+		if v.Nanosecond() == 0 {
+			l += 9
+		} else {
+			l += 13
+		}
+	}
+
+	if x := len(o.Phone); x != 0 {
+		l += x
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	if v := o.Siblings; v != 0 {
+		x := uint32(v)
+		if v < 0 {
+			x = ^x + 1
+		}
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	if o.Spouse {
+		l++
+	}
+
+	if o.Money != 0.0 {
+		l += 9
+	}
+
+	if x := len(o.Tags); x != 0 {
+		for _, v := range o.Tags {
+			l += v.MarshalLen()
+		}
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	if x := len(o.Aliases); x != 0 {
+		for _, a := range o.Aliases {
+			l += len(a)
+			for x := len(a); x >= 0x80; x >>= 7 {
+				l++
+			}
+			l++
+		}
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	return l
+}
+
+// MarshalBinary encodes o as Colfer conform encoding.BinaryMarshaler.
+// The error return is always nil.
+func (o *ColferTaggedA) MarshalBinary() (data []byte, err error) {
+	data = make([]byte, o.MarshalLen())
+	o.MarshalTo(data)
+	return data, nil
+}
+
+// UnmarshalBinary decodes data as Colfer conform encoding.BinaryUnmarshaler.
+// The error return options are io.EOF, goserbench.ColferError, and goserbench.ColferContinue.
+func (o *ColferTaggedA) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return io.EOF
+	}
+	if data[0] != 0x80 {
+		return ColferError(0)
+	}
+
+	if len(data) == 1 {
+		return io.EOF
+	}
+	header := data[1]
+	i := 2
+
+	if header == 0 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+
+		to := i + int(x)
+		if to >= len(data) {
+			return io.EOF
+		}
+		o.Name = string(data[i:to])
+
+		header = data[to]
+		i = to + 1
+	}
+
+	if header == 1 {
+		if i+8 >= len(data) {
+			return io.EOF
+		}
+		sec := uint64(data[i])<<56 | uint64(data[i+1])<<48 | uint64(data[i+2])<<40 | uint64(data[i+3])<<32
+		sec |= uint64(data[i+4])<<24 | uint64(data[i+5])<<16 | uint64(data[i+6])<<8 | uint64(data[i+7])
+		o.BirthDay = time.Unix(int64(sec), 0)
+
+		header = data[i+8]
+		i += 9
+	} else if header == 1|0x80 {
+		if i+12 >= len(data) {
+			return io.EOF
+		}
+		sec := uint64(data[i])<<56 | uint64(data[i+1])<<48 | uint64(data[i+2])<<40 | uint64(data[i+3])<<32
+		sec |= uint64(data[i+4])<<24 | uint64(data[i+5])<<16 | uint64(data[i+6])<<8 | uint64(data[i+7])
+		nsec := int64(uint(data[i+8])<<24 | uint(data[i+9])<<16 | uint(data[i+10])<<8 | uint(data[i+11]))
+		o.BirthDay = time.Unix(int64(sec), nsec)
+
+		header = data[i+12]
+		i += 13
+	}
+
+	if header == 2 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+
+		to := i + int(x)
+		if to >= len(data) {
+			return io.EOF
+		}
+		o.Phone = string(data[i:to])
+
+		header = data[to]
+		i = to + 1
+	}
+
+	if header == 3 || header == 3|0x80 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		if header&0x80 != 0 {
+			x = ^x + 1
+		}
+		o.Siblings = int32(x)
+
+		if i == len(data) {
+			return io.EOF
+		}
+		header = data[i]
+		i++
+	}
+
+	if header == 4 {
+		o.Spouse = true
+
+		if i == len(data) {
+			return io.EOF
+		}
+		header = data[i]
+		i++
+	}
+
+	if header == 5 {
+		if i+8 >= len(data) {
+			return io.EOF
+		}
+		x := uint64(data[i])<<56 | uint64(data[i+1])<<48 | uint64(data[i+2])<<40 | uint64(data[i+3])<<32
+		x |= uint64(data[i+4])<<24 | uint64(data[i+5])<<16 | uint64(data[i+6])<<8 | uint64(data[i+7])
+		o.Money = math.Float64frombits(x)
+
+		header = data[i+8]
+		i += 9
+	}
+
+	if header == 6 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+
+		a := make([]ColferTag, int(x))
+		o.Tags = make([]*ColferTag, int(x))
+		for ai := range a {
+			v := &a[ai]
+			err := v.UnmarshalBinary(data[i:])
+			cont, ok := err.(ColferContinue)
+			if !ok {
+				if err == nil {
+					err = io.EOF
+				}
+				return err
+			}
+			i += int(cont)
+			o.Tags[ai] = v
+		}
+
+		if i == len(data) {
+			return io.EOF
+		}
+		header = data[i]
+		i++
+	}
+
+	if header == 7 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+
+		o.Aliases = make([]string, int(x))
+		for ai := range o.Aliases {
+			var x uint32
+			for shift := uint(0); ; shift += 7 {
+				if i == len(data) {
+					return io.EOF
+				}
+				b := data[i]
+				i++
+				if shift == 28 {
+					x |= uint32(b) << 28
+					break
+				}
+				x |= (uint32(b) & 0x7f) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			to := i + int(x)
+			if to > len(data) {
+				return io.EOF
+			}
+			o.Aliases[ai] = string(data[i:to])
+			i = to
+		}
+
+		if i == len(data) {
+			return io.EOF
+		}
+		header = data[i]
+		i++
+	}
+
+	if header != 0x7f {
+		return ColferError(i - 1)
+	}
+	if i != len(data) {
+		return ColferContinue(i)
+	}
+	return nil
+}
+
+type ColferTag struct {
+	Key   string
+	Value string
+}
+
+// MarshalTo encodes o as Colfer into buf and returns the number of bytes written.
+// If the buffer is too small, MarshalTo will panic.
+func (o *ColferTag) MarshalTo(buf []byte) int {
+	if o == nil {
+		return 0
+	}
+
+	buf[0] = 0x80
+	i := 1
+
+	if v := o.Key; len(v) != 0 {
+		buf[i] = 0
+		i++
+		x := uint(len(v))
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+		to := i + len(v)
+		copy(buf[i:], v)
+		i = to
+	}
+
+	if v := o.Value; len(v) != 0 {
+		buf[i] = 1
+		i++
+		x := uint(len(v))
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+		to := i + len(v)
+		copy(buf[i:], v)
+		i = to
+	}
+
+	buf[i] = 0x7f
+	i++
+	return i
+}
+
+// MarshalLen returns the Colfer serial byte size.
+func (o *ColferTag) MarshalLen() int {
+	if o == nil {
+		return 0
+	}
+
+	l := 2
+
+	if x := len(o.Key); x != 0 {
+		l += x
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	if x := len(o.Value); x != 0 {
+		l += x
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	return l
+}
+
+// MarshalBinary encodes o as Colfer conform encoding.BinaryMarshaler.
+// The error return is always nil.
+func (o *ColferTag) MarshalBinary() (data []byte, err error) {
+	data = make([]byte, o.MarshalLen())
+	o.MarshalTo(data)
+	return data, nil
+}
+
+// UnmarshalBinary decodes data as Colfer conform encoding.BinaryUnmarshaler.
+// The error return options are io.EOF, goserbench.ColferError, and goserbench.ColferContinue.
+func (o *ColferTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return io.EOF
+	}
+	if data[0] != 0x80 {
+		return ColferError(0)
+	}
+
+	if len(data) == 1 {
+		return io.EOF
+	}
+	header := data[1]
+	i := 2
+
+	if header == 0 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+
+		to := i + int(x)
+		if to >= len(data) {
+			return io.EOF
+		}
+		o.Key = string(data[i:to])
+
+		header = data[to]
+		i = to + 1
+	}
+
+	if header == 1 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+
+		to := i + int(x)
+		if to >= len(data) {
+			return io.EOF
+		}
+		o.Value = string(data[i:to])
+
+		header = data[to]
+		i = to + 1
+	}
+
+	if header != 0x7f {
+		return ColferError(i - 1)
+	}
+	if i != len(data) {
+		return ColferContinue(i)
+	}
+	return nil
+}
+
+type ColferBlob struct {
+	Name string
+	Data []byte
+}
+
+// MarshalTo encodes o as Colfer into buf and returns the number of bytes written.
+// If the buffer is too small, MarshalTo will panic.
+func (o *ColferBlob) MarshalTo(buf []byte) int {
+	if o == nil {
+		return 0
+	}
+
+	buf[0] = 0x80
+	i := 1
+
+	if v := o.Name; len(v) != 0 {
+		buf[i] = 0
+		i++
+		x := uint(len(v))
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+		to := i + len(v)
+		copy(buf[i:], v)
+		i = to
+	}
+
+	if v := o.Data; len(v) != 0 {
+		buf[i] = 1
+		i++
+		x := uint(len(v))
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+		to := i + len(v)
+		copy(buf[i:], v)
+		i = to
+	}
+
+	buf[i] = 0x7f
+	i++
+	return i
+}
+
+// MarshalLen returns the Colfer serial byte size.
+func (o *ColferBlob) MarshalLen() int {
+	if o == nil {
+		return 0
+	}
+
+	l := 2
+
+	if x := len(o.Name); x != 0 {
+		l += x
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	if x := len(o.Data); x != 0 {
+		l += x
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	return l
+}
+
+// MarshalBinary encodes o as Colfer conform encoding.BinaryMarshaler.
+// The error return is always nil.
+func (o *ColferBlob) MarshalBinary() (data []byte, err error) {
+	data = make([]byte, o.MarshalLen())
+	o.MarshalTo(data)
+	return data, nil
+}
+
+// UnmarshalBinary decodes data as Colfer conform encoding.BinaryUnmarshaler.
+// The error return options are io.EOF, goserbench.ColferError, and goserbench.ColferContinue.
+func (o *ColferBlob) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return io.EOF
+	}
+	if data[0] != 0x80 {
+		return ColferError(0)
+	}
+
+	if len(data) == 1 {
+		return io.EOF
+	}
+	header := data[1]
+	i := 2
+
+	if header == 0 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+
+		to := i + int(x)
+		if to >= len(data) {
+			return io.EOF
+		}
+		o.Name = string(data[i:to])
+
+		header = data[to]
+		i = to + 1
+	}
+
+	if header == 1 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+
+		to := i + int(x)
+		if to >= len(data) {
+			return io.EOF
+		}
+		o.Data = make([]byte, int(x))
+		copy(o.Data, data[i:])
+
+		header = data[to]
+		i = to + 1
+	}
+
+	if header != 0x7f {
+		return ColferError(i - 1)
+	}
+	if i != len(data) {
+		return ColferContinue(i)
+	}
+	return nil
+}
+
+type ColferBatch struct {
+	Records []*ColferA
+}
+
+// MarshalTo encodes o as Colfer into buf and returns the number of bytes written.
+// If the buffer is too small, MarshalTo will panic.
+func (o *ColferBatch) MarshalTo(buf []byte) int {
+	if o == nil {
+		return 0
+	}
+
+	buf[0] = 0x80
+	i := 1
+
+	if l := len(o.Records); l != 0 {
+		buf[i] = 0
+		i++
+		x := uint(l)
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+		for _, v := range o.Records {
+			i += v.MarshalTo(buf[i:])
+		}
+	}
+
+	buf[i] = 0x7f
+	i++
+	return i
+}
+
+// MarshalLen returns the Colfer serial byte size.
+func (o *ColferBatch) MarshalLen() int {
+	if o == nil {
+		return 0
+	}
+
+	l := 2
+
+	if x := len(o.Records); x != 0 {
+		for _, v := range o.Records {
+			l += v.MarshalLen()
+		}
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	return l
+}
+
+// MarshalBinary encodes o as Colfer conform encoding.BinaryMarshaler.
+// The error return is always nil.
+func (o *ColferBatch) MarshalBinary() (data []byte, err error) {
+	data = make([]byte, o.MarshalLen())
+	o.MarshalTo(data)
+	return data, nil
+}
+
+// UnmarshalBinary decodes data as Colfer conform encoding.BinaryUnmarshaler.
+// The error return options are io.EOF, goserbench.ColferError, and goserbench.ColferContinue.
+func (o *ColferBatch) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return io.EOF
+	}
+	if data[0] != 0x80 {
+		return ColferError(0)
+	}
+
+	if len(data) == 1 {
+		return io.EOF
+	}
+	header := data[1]
+	i := 2
+
+	if header == 0 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+
+		a := make([]ColferA, int(x))
+		o.Records = make([]*ColferA, int(x))
+		for ai := range a {
+			v := &a[ai]
+			err := v.UnmarshalBinary(data[i:])
+			cont, ok := err.(ColferContinue)
+			if !ok {
+				if err == nil {
+					err = io.EOF
+				}
+				return err
+			}
+			i += int(cont)
+			o.Records[ai] = v
+		}
+
+		if i == len(data) {
+			return io.EOF
+		}
+		header = data[i]
+		i++
+	}
+
+	if header != 0x7f {
+		return ColferError(i - 1)
+	}
+	if i != len(data) {
+		return ColferContinue(i)
+	}
+	return nil
+}
+
+type ColferSeries struct {
+	Name       string
+	Timestamps []byte
+	Values     []float64
+}
+
+// MarshalTo encodes o as Colfer into buf and returns the number of bytes written.
+// If the buffer is too small, MarshalTo will panic.
+func (o *ColferSeries) MarshalTo(buf []byte) int {
+	if o == nil {
+		return 0
+	}
+
+	buf[0] = 0x80
+	i := 1
+
+	if v := o.Name; len(v) != 0 {
+		buf[i] = 0
+		i++
+		x := uint(len(v))
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+		to := i + len(v)
+		copy(buf[i:], v)
+		i = to
+	}
+
+	if v := o.Timestamps; len(v) != 0 {
+		buf[i] = 1
+		i++
+		x := uint(len(v))
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+		to := i + len(v)
+		copy(buf[i:], v)
+		i = to
+	}
+
+	if l := len(o.Values); l != 0 {
+		buf[i] = 2
+		i++
+		x := uint(l)
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+		for _, v := range o.Values {
+			x := math.Float64bits(v)
+			buf[i], buf[i+1], buf[i+2], buf[i+3] = byte(x>>56), byte(x>>48), byte(x>>40), byte(x>>32)
+			buf[i+4], buf[i+5], buf[i+6], buf[i+7] = byte(x>>24), byte(x>>16), byte(x>>8), byte(x)
+			i += 8
+		}
+	}
+
+	buf[i] = 0x7f
+	i++
+	return i
+}
+
+// MarshalLen returns the Colfer serial byte size.
+func (o *ColferSeries) MarshalLen() int {
+	if o == nil {
+		return 0
+	}
+
+	l := 2
+
+	if x := len(o.Name); x != 0 {
+		l += x
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	if x := len(o.Timestamps); x != 0 {
+		l += x
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	if x := len(o.Values); x != 0 {
+		l += 8 * x
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	return l
+}
+
+// MarshalBinary encodes o as Colfer conform encoding.BinaryMarshaler.
+// The error return is always nil.
+func (o *ColferSeries) MarshalBinary() (data []byte, err error) {
+	data = make([]byte, o.MarshalLen())
+	o.MarshalTo(data)
+	return data, nil
+}
+
+// UnmarshalBinary decodes data as Colfer conform encoding.BinaryUnmarshaler.
+// The error return options are io.EOF, goserbench.ColferError, and goserbench.ColferContinue.
+func (o *ColferSeries) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return io.EOF
+	}
+	if data[0] != 0x80 {
+		return ColferError(0)
+	}
+
+	if len(data) == 1 {
+		return io.EOF
+	}
+	header := data[1]
+	i := 2
+
+	if header == 0 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+
+		to := i + int(x)
+		if to >= len(data) {
+			return io.EOF
+		}
+		o.Name = string(data[i:to])
+
+		header = data[to]
+		i = to + 1
+	}
+
+	if header == 1 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+
+		to := i + int(x)
+		if to >= len(data) {
+			return io.EOF
+		}
+		o.Timestamps = make([]byte, int(x))
+		copy(o.Timestamps, data[i:])
+
+		header = data[to]
+		i = to + 1
+	}
+
+	if header == 2 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+
+		to := i + 8*int(x)
+		if to > len(data) {
+			return io.EOF
+		}
+		o.Values = make([]float64, int(x))
+		for ai := range o.Values {
+			x := uint64(data[i])<<56 | uint64(data[i+1])<<48 | uint64(data[i+2])<<40 | uint64(data[i+3])<<32
+			x |= uint64(data[i+4])<<24 | uint64(data[i+5])<<16 | uint64(data[i+6])<<8 | uint64(data[i+7])
+			o.Values[ai] = math.Float64frombits(x)
+			i += 8
+		}
+
+		if i == len(data) {
+			return io.EOF
+		}
+		header = data[i]
+		i++
+	}
+
+	if header != 0x7f {
+		return ColferError(i - 1)
+	}
+	if i != len(data) {
+		return ColferContinue(i)
+	}
+	return nil
+}
+
+type ColferEvent struct {
+	ID       int64
+	Click    *ColferClick
+	Purchase *ColferPurchase
+	Signup   *ColferSignup
+}
+
+// MarshalTo encodes o as Colfer into buf and returns the number of bytes written.
+// If the buffer is too small, MarshalTo will panic.
+func (o *ColferEvent) MarshalTo(buf []byte) int {
+	if o == nil {
+		return 0
+	}
+
+	buf[0] = 0x80
+	i := 1
+
+	if v := o.ID; v != 0 {
+		x := uint64(v)
+		if v >= 0 {
+			buf[i] = 0
+		} else {
+			x = ^x + 1
+			buf[i] = 0 | 0x80
+		}
+		i++
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+	}
+
+	if v := o.Click; v != nil {
+		buf[i] = 1
+		i++
+		i += v.MarshalTo(buf[i:])
+	}
+
+	if v := o.Purchase; v != nil {
+		buf[i] = 2
+		i++
+		i += v.MarshalTo(buf[i:])
+	}
+
+	if v := o.Signup; v != nil {
+		buf[i] = 3
+		i++
+		i += v.MarshalTo(buf[i:])
+	}
+
+	buf[i] = 0x7f
+	i++
+	return i
+}
+
+// MarshalLen returns the Colfer serial byte size.
+func (o *ColferEvent) MarshalLen() int {
+	if o == nil {
+		return 0
+	}
+
+	l := 2
+
+	if v := o.ID; v != 0 {
+		x := uint64(v)
+		if v < 0 {
+			x = ^x + 1
+		}
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	if v := o.Click; v != nil {
+		l += v.MarshalLen() + 1
+	}
+
+	if v := o.Purchase; v != nil {
+		l += v.MarshalLen() + 1
+	}
+
+	if v := o.Signup; v != nil {
+		l += v.MarshalLen() + 1
+	}
+
+	return l
+}
+
+// MarshalBinary encodes o as Colfer conform encoding.BinaryMarshaler.
+// The error return is always nil.
+func (o *ColferEvent) MarshalBinary() (data []byte, err error) {
+	data = make([]byte, o.MarshalLen())
+	o.MarshalTo(data)
+	return data, nil
+}
+
+// UnmarshalBinary decodes data as Colfer conform encoding.BinaryUnmarshaler.
+// The error return options are io.EOF, goserbench.ColferError, and goserbench.ColferContinue.
+func (o *ColferEvent) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return io.EOF
+	}
+	if data[0] != 0x80 {
+		return ColferError(0)
+	}
+
+	if len(data) == 1 {
+		return io.EOF
+	}
+	header := data[1]
+	i := 2
+
+	if header == 0 || header == 0|0x80 {
+		var x uint64
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 56 {
+				x |= uint64(b) << 56
+				break
+			}
+			x |= (uint64(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		if header&0x80 != 0 {
+			x = ^x + 1
+		}
+		o.ID = int64(x)
+
+		if i == len(data) {
+			return io.EOF
+		}
+		header = data[i]
+		i++
+	}
+
+	if header == 1 {
+		o.Click = new(ColferClick)
+		err := o.Click.UnmarshalBinary(data[i:])
+		cont, ok := err.(ColferContinue)
+		if !ok {
+			if err == nil {
+				err = io.EOF
+			}
+			return err
+		}
+		i += int(cont)
+
+		header = data[i]
+		i++
+	}
+
+	if header == 2 {
+		o.Purchase = new(ColferPurchase)
+		err := o.Purchase.UnmarshalBinary(data[i:])
+		cont, ok := err.(ColferContinue)
+		if !ok {
+			if err == nil {
+				err = io.EOF
+			}
+			return err
+		}
+		i += int(cont)
+
+		header = data[i]
+		i++
+	}
+
+	if header == 3 {
+		o.Signup = new(ColferSignup)
+		err := o.Signup.UnmarshalBinary(data[i:])
+		cont, ok := err.(ColferContinue)
+		if !ok {
+			if err == nil {
+				err = io.EOF
+			}
+			return err
+		}
+		i += int(cont)
+
+		header = data[i]
+		i++
+	}
+
+	if header != 0x7f {
+		return ColferError(i - 1)
+	}
+	if i != len(data) {
+		return ColferContinue(i)
+	}
+	return nil
+}
+
+type ColferClick struct {
+	Page string
+	X    int32
+	Y    int32
+}
+
+// MarshalTo encodes o as Colfer into buf and returns the number of bytes written.
+// If the buffer is too small, MarshalTo will panic.
+func (o *ColferClick) MarshalTo(buf []byte) int {
+	if o == nil {
+		return 0
+	}
+
+	buf[0] = 0x80
+	i := 1
+
+	if v := o.Page; len(v) != 0 {
+		buf[i] = 0
+		i++
+		x := uint(len(v))
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+		to := i + len(v)
+		copy(buf[i:], v)
+		i = to
+	}
+
+	if v := o.X; v != 0 {
+		x := uint32(v)
+		if v >= 0 {
+			buf[i] = 1
+		} else {
+			x = ^x + 1
+			buf[i] = 1 | 0x80
+		}
+		i++
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+	}
+
+	if v := o.Y; v != 0 {
+		x := uint32(v)
+		if v >= 0 {
+			buf[i] = 2
+		} else {
+			x = ^x + 1
+			buf[i] = 2 | 0x80
+		}
+		i++
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+	}
+
+	buf[i] = 0x7f
+	i++
+	return i
+}
+
+// MarshalLen returns the Colfer serial byte size.
+func (o *ColferClick) MarshalLen() int {
+	if o == nil {
+		return 0
+	}
+
+	l := 2
+
+	if x := len(o.Page); x != 0 {
+		l += x
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	if v := o.X; v != 0 {
+		x := uint32(v)
+		if v < 0 {
+			x = ^x + 1
+		}
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	if v := o.Y; v != 0 {
+		x := uint32(v)
+		if v < 0 {
+			x = ^x + 1
+		}
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	return l
+}
+
+// MarshalBinary encodes o as Colfer conform encoding.BinaryMarshaler.
+// The error return is always nil.
+func (o *ColferClick) MarshalBinary() (data []byte, err error) {
+	data = make([]byte, o.MarshalLen())
+	o.MarshalTo(data)
+	return data, nil
+}
+
+// UnmarshalBinary decodes data as Colfer conform encoding.BinaryUnmarshaler.
+// The error return options are io.EOF, goserbench.ColferError, and goserbench.ColferContinue.
+func (o *ColferClick) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return io.EOF
+	}
+	if data[0] != 0x80 {
+		return ColferError(0)
+	}
+
+	if len(data) == 1 {
+		return io.EOF
+	}
+	header := data[1]
+	i := 2
+
+	if header == 0 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+
+		to := i + int(x)
+		if to >= len(data) {
+			return io.EOF
+		}
+		o.Page = string(data[i:to])
+
+		header = data[to]
+		i = to + 1
+	}
+
+	if header == 1 || header == 1|0x80 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		if header&0x80 != 0 {
+			x = ^x + 1
+		}
+		o.X = int32(x)
+
+		if i == len(data) {
+			return io.EOF
+		}
+		header = data[i]
+		i++
+	}
+
+	if header == 2 || header == 2|0x80 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		if header&0x80 != 0 {
+			x = ^x + 1
+		}
+		o.Y = int32(x)
+
+		if i == len(data) {
+			return io.EOF
+		}
+		header = data[i]
+		i++
+	}
+
+	if header != 0x7f {
+		return ColferError(i - 1)
+	}
+	if i != len(data) {
+		return ColferContinue(i)
+	}
+	return nil
+}
+
+type ColferPurchase struct {
+	Item     string
+	Quantity int32
+	Price    float64
+}
+
+// MarshalTo encodes o as Colfer into buf and returns the number of bytes written.
+// If the buffer is too small, MarshalTo will panic.
+func (o *ColferPurchase) MarshalTo(buf []byte) int {
+	if o == nil {
+		return 0
+	}
+
+	buf[0] = 0x80
+	i := 1
+
+	if v := o.Item; len(v) != 0 {
+		buf[i] = 0
+		i++
+		x := uint(len(v))
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+		to := i + len(v)
+		copy(buf[i:], v)
+		i = to
+	}
+
+	if v := o.Quantity; v != 0 {
+		x := uint32(v)
+		if v >= 0 {
+			buf[i] = 1
+		} else {
+			x = ^x + 1
+			buf[i] = 1 | 0x80
+		}
+		i++
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+	}
+
+	if v := o.Price; v != 0.0 {
+		buf[i] = 2
+		x := math.Float64bits(v)
+		buf[i+1], buf[i+2], buf[i+3], buf[i+4] = byte(x>>56), byte(x>>48), byte(x>>40), byte(x>>32)
+		buf[i+5], buf[i+6], buf[i+7], buf[i+8] = byte(x>>24), byte(x>>16), byte(x>>8), byte(x)
+		i += 9
+	}
+
+	buf[i] = 0x7f
+	i++
+	return i
+}
+
+// MarshalLen returns the Colfer serial byte size.
+func (o *ColferPurchase) MarshalLen() int {
+	if o == nil {
+		return 0
+	}
+
+	l := 2
+
+	if x := len(o.Item); x != 0 {
+		l += x
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	if v := o.Quantity; v != 0 {
+		x := uint32(v)
+		if v < 0 {
+			x = ^x + 1
+		}
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	if o.Price != 0.0 {
+		l += 9
+	}
+
+	return l
+}
+
+// MarshalBinary encodes o as Colfer conform encoding.BinaryMarshaler.
+// The error return is always nil.
+func (o *ColferPurchase) MarshalBinary() (data []byte, err error) {
+	data = make([]byte, o.MarshalLen())
+	o.MarshalTo(data)
+	return data, nil
+}
+
+// UnmarshalBinary decodes data as Colfer conform encoding.BinaryUnmarshaler.
+// The error return options are io.EOF, goserbench.ColferError, and goserbench.ColferContinue.
+func (o *ColferPurchase) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return io.EOF
+	}
+	if data[0] != 0x80 {
+		return ColferError(0)
+	}
+
+	if len(data) == 1 {
+		return io.EOF
+	}
+	header := data[1]
+	i := 2
+
+	if header == 0 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+
+		to := i + int(x)
+		if to >= len(data) {
+			return io.EOF
+		}
+		o.Item = string(data[i:to])
+
+		header = data[to]
+		i = to + 1
+	}
+
+	if header == 1 || header == 1|0x80 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		if header&0x80 != 0 {
+			x = ^x + 1
+		}
+		o.Quantity = int32(x)
+
+		if i == len(data) {
+			return io.EOF
+		}
+		header = data[i]
+		i++
+	}
+
+	if header == 2 {
+		if i+8 >= len(data) {
+			return io.EOF
+		}
+		x := uint64(data[i])<<56 | uint64(data[i+1])<<48 | uint64(data[i+2])<<40 | uint64(data[i+3])<<32
+		x |= uint64(data[i+4])<<24 | uint64(data[i+5])<<16 | uint64(data[i+6])<<8 | uint64(data[i+7])
+		o.Price = math.Float64frombits(x)
+
+		header = data[i+8]
+		i += 9
+	}
+
+	if header != 0x7f {
+		return ColferError(i - 1)
+	}
+	if i != len(data) {
+		return ColferContinue(i)
+	}
+	return nil
+}
+
+type ColferSignup struct {
+	Name     string
+	Email    string
+	Referrer string
+}
+
+// MarshalTo encodes o as Colfer into buf and returns the number of bytes written.
+// If the buffer is too small, MarshalTo will panic.
+func (o *ColferSignup) MarshalTo(buf []byte) int {
+	if o == nil {
+		return 0
+	}
+
+	buf[0] = 0x80
+	i := 1
+
+	if v := o.Name; len(v) != 0 {
+		buf[i] = 0
+		i++
+		x := uint(len(v))
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+		to := i + len(v)
+		copy(buf[i:], v)
+		i = to
+	}
+
+	if v := o.Email; len(v) != 0 {
+		buf[i] = 1
+		i++
+		x := uint(len(v))
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+		to := i + len(v)
+		copy(buf[i:], v)
+		i = to
+	}
+
+	if v := o.Referrer; len(v) != 0 {
+		buf[i] = 2
+		i++
+		x := uint(len(v))
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+		to := i + len(v)
+		copy(buf[i:], v)
+		i = to
+	}
+
+	buf[i] = 0x7f
+	i++
+	return i
+}
+
+// MarshalLen returns the Colfer serial byte size.
+func (o *ColferSignup) MarshalLen() int {
+	if o == nil {
+		return 0
+	}
+
+	l := 2
+
+	if x := len(o.Name); x != 0 {
+		l += x
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	if x := len(o.Email); x != 0 {
+		l += x
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	if x := len(o.Referrer); x != 0 {
+		l += x
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	return l
+}
+
+// MarshalBinary encodes o as Colfer conform encoding.BinaryMarshaler.
+// The error return is always nil.
+func (o *ColferSignup) MarshalBinary() (data []byte, err error) {
+	data = make([]byte, o.MarshalLen())
+	o.MarshalTo(data)
+	return data, nil
+}
+
+// UnmarshalBinary decodes data as Colfer conform encoding.BinaryUnmarshaler.
+// The error return options are io.EOF, goserbench.ColferError, and goserbench.ColferContinue.
+func (o *ColferSignup) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return io.EOF
+	}
+	if data[0] != 0x80 {
+		return ColferError(0)
+	}
+
+	if len(data) == 1 {
+		return io.EOF
+	}
+	header := data[1]
+	i := 2
+
+	if header == 0 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+
+		to := i + int(x)
+		if to >= len(data) {
+			return io.EOF
+		}
+		o.Name = string(data[i:to])
+
+		header = data[to]
+		i = to + 1
+	}
+
+	if header == 1 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+
+		to := i + int(x)
+		if to >= len(data) {
+			return io.EOF
+		}
+		o.Email = string(data[i:to])
+
+		header = data[to]
+		i = to + 1
+	}
+
+	if header == 2 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+
+		to := i + int(x)
+		if to >= len(data) {
+			return io.EOF
+		}
+		o.Referrer = string(data[i:to])
+
+		header = data[to]
+		i = to + 1
+	}
+
+	if header != 0x7f {
+		return ColferError(i - 1)
+	}
+	if i != len(data) {
+		return ColferContinue(i)
+	}
+	return nil
+}
+
+type ColferNode struct {
+	Name     string
+	Value    int64
+	Children []*ColferNode
+}
+
+// MarshalTo encodes o as Colfer into buf and returns the number of bytes written.
+// If the buffer is too small, MarshalTo will panic.
+func (o *ColferNode) MarshalTo(buf []byte) int {
+	if o == nil {
+		return 0
+	}
+
+	buf[0] = 0x80
+	i := 1
+
+	if v := o.Name; len(v) != 0 {
+		buf[i] = 0
+		i++
+		x := uint(len(v))
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+		to := i + len(v)
+		copy(buf[i:], v)
+		i = to
+	}
+
+	if v := o.Value; v != 0 {
+		x := uint64(v)
+		if v >= 0 {
+			buf[i] = 1
+		} else {
+			x = ^x + 1
+			buf[i] = 1 | 0x80
+		}
+		i++
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+	}
+
+	if l := len(o.Children); l != 0 {
+		buf[i] = 2
+		i++
+		x := uint(l)
+		for x >= 0x80 {
+			buf[i] = byte(x | 0x80)
+			x >>= 7
+			i++
+		}
+		buf[i] = byte(x)
+		i++
+		for _, v := range o.Children {
+			i += v.MarshalTo(buf[i:])
+		}
+	}
+
+	buf[i] = 0x7f
+	i++
+	return i
+}
+
+// MarshalLen returns the Colfer serial byte size.
+func (o *ColferNode) MarshalLen() int {
+	if o == nil {
+		return 0
+	}
+
+	l := 2
+
+	if x := len(o.Name); x != 0 {
+		l += x
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	if v := o.Value; v != 0 {
+		x := uint64(v)
+		if v < 0 {
+			x = ^x + 1
+		}
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	if x := len(o.Children); x != 0 {
+		for _, v := range o.Children {
+			l += v.MarshalLen()
+		}
+		for x >= 0x80 {
+			x >>= 7
+			l++
+		}
+		l += 2
+	}
+
+	return l
+}
+
+// MarshalBinary encodes o as Colfer conform encoding.BinaryMarshaler.
+// The error return is always nil.
+func (o *ColferNode) MarshalBinary() (data []byte, err error) {
+	data = make([]byte, o.MarshalLen())
+	o.MarshalTo(data)
+	return data, nil
+}
+
+// UnmarshalBinary decodes data as Colfer conform encoding.BinaryUnmarshaler.
+// The error return options are io.EOF, goserbench.ColferError, and goserbench.ColferContinue.
+func (o *ColferNode) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return io.EOF
+	}
+	if data[0] != 0x80 {
+		return ColferError(0)
+	}
+
+	if len(data) == 1 {
+		return io.EOF
+	}
+	header := data[1]
+	i := 2
+
+	if header == 0 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+
+		to := i + int(x)
+		if to >= len(data) {
+			return io.EOF
+		}
+		o.Name = string(data[i:to])
+
+		header = data[to]
+		i = to + 1
+	}
+
+	if header == 1 || header == 1|0x80 {
+		var x uint64
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 56 {
+				x |= uint64(b) << 56
+				break
+			}
+			x |= (uint64(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		if header&0x80 != 0 {
+			x = ^x + 1
+		}
+		o.Value = int64(x)
+
+		if i == len(data) {
+			return io.EOF
+		}
+		header = data[i]
+		i++
+	}
+
+	if header == 2 {
+		var x uint32
+		for shift := uint(0); ; shift += 7 {
+			if i == len(data) {
+				return io.EOF
+			}
+			b := data[i]
+			i++
+			if shift == 28 {
+				x |= uint32(b) << 28
+				break
+			}
+			x |= (uint32(b) & 0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+
+		a := make([]ColferNode, int(x))
+		o.Children = make([]*ColferNode, int(x))
+		for ai := range a {
+			v := &a[ai]
+			err := v.UnmarshalBinary(data[i:])
+			cont, ok := err.(ColferContinue)
+			if !ok {
+				if err == nil {
+					err = io.EOF
+				}
+				return err
+			}
+			i += int(cont)
+			o.Children[ai] = v
+		}
+
+		if i == len(data) {
+			return io.EOF
+		}
+		header = data[i]
+		i++
+	}
+
+	if header != 0x7f {
+		return ColferError(i - 1)
+	}
+	if i != len(data) {
+		return ColferContinue(i)
+	}
+	return nil
+}
@@ -0,0 +1,271 @@
+package goserbench
+
+import (
+	"time"
+
+	"github.com/google/flatbuffers/go"
+)
+
+// payloadBatch holds a single message: the whole corpus of payload A, as a
+// page of results would be sent at once. Its per-record numbers compare
+// directly with those of BenchmarkSerializers, which encodes the same records
+// one message each.
+var payloadBatch = &Payload{
+	Name: "Batch",
+	Generate: func() []interface{} {
+		records := payloadA.Records()
+		b := &Batch{Records: make([]A, len(records))}
+		for i, r := range records {
+			b.Records[i] = *r.(*A)
+		}
+		return []interface{}{b}
+	},
+	New:        func() interface{} { return &Batch{} },
+	PerMessage: corpusSize,
+}
+
+// github.com/google/flatbuffers/go
+
+type FlatBufferBatchSerializer struct {
+	builder *flatbuffers.Builder
+	offsets []flatbuffers.UOffsetT
+}
+
+func (s *FlatBufferBatchSerializer) Marshal(o interface{}) ([]byte, error) {
+	batch := o.(*Batch)
+	builder := s.builder
+
+	builder.Reset()
+
+	s.offsets = s.offsets[:0]
+	for i := range batch.Records {
+		a := &batch.Records[i]
+		name := builder.CreateString(a.Name)
+		phone := builder.CreateString(a.Phone)
+
+		FlatBufferAStart(builder)
+		FlatBufferAAddName(builder, name)
+		FlatBufferAAddPhone(builder, phone)
+		FlatBufferAAddBirthDay(builder, a.BirthDay.UnixNano())
+		FlatBufferAAddSiblings(builder, int32(a.Siblings))
+		var spouse byte
+		if a.Spouse {
+			spouse = byte(1)
+		}
+		FlatBufferAAddSpouse(builder, spouse)
+		FlatBufferAAddMoney(builder, a.Money)
+		s.offsets = append(s.offsets, FlatBufferAEnd(builder))
+	}
+	FlatBufferBatchStartRecordsVector(builder, len(s.offsets))
+	for i := len(s.offsets) - 1; i >= 0; i-- {
+		builder.PrependUOffsetT(s.offsets[i])
+	}
+	records := builder.EndVector(len(s.offsets))
+
+	FlatBufferBatchStart(builder)
+	FlatBufferBatchAddRecords(builder, records)
+	builder.Finish(FlatBufferBatchEnd(builder))
+	return builder.Bytes[builder.Head():], nil
+}
+
+func (s *FlatBufferBatchSerializer) Unmarshal(d []byte, i interface{}) error {
+	batch := i.(*Batch)
+	o := FlatBufferBatch{}
+	o.Init(d, flatbuffers.GetUOffsetT(d))
+	batch.Records = make([]A, o.RecordsLength())
+	var r FlatBufferA
+	for j := range batch.Records {
+		o.Records(&r, j)
+		a := &batch.Records[j]
+		a.Name = string(r.Name())
+		a.BirthDay = time.Unix(0, r.BirthDay())
+		a.Phone = string(r.Phone())
+		a.Siblings = int(r.Siblings())
+		a.Spouse = r.Spouse() == byte(1)
+		a.Money = r.Money()
+	}
+	return nil
+}
+
+func (s *FlatBufferBatchSerializer) String() string {
+	return "FlatBuffer"
+}
+
+// The batch converters convert record by record with the Converter of A.
+
+// github.com/golang/protobuf
+
+var protoBufBatchConverter = &Converter{
+	New: func() interface{} { return &ProtoBufBatch{} },
+	From: func(dst, src interface{}) {
+		b := src.(*Batch)
+		o := dst.(*ProtoBufBatch)
+		o.Records = make([]*ProtoBufA, len(b.Records))
+		for i := range b.Records {
+			o.Records[i] = &ProtoBufA{}
+			protoBufAConverter.From(o.Records[i], &b.Records[i])
+		}
+	},
+	To: func(dst, src interface{}) {
+		b := dst.(*Batch)
+		o := src.(*ProtoBufBatch)
+		b.Records = make([]A, len(o.Records))
+		for i, r := range o.Records {
+			protoBufAConverter.To(&b.Records[i], r)
+		}
+	},
+}
+
+// github.com/gogo/protobuf/proto
+
+var gogoProtoBufBatchConverter = &Converter{
+	New: func() interface{} { return &GogoProtoBufBatch{} },
+	From: func(dst, src interface{}) {
+		b := src.(*Batch)
+		o := dst.(*GogoProtoBufBatch)
+		o.Records = make([]GogoProtoBufA, len(b.Records))
+		for i := range b.Records {
+			gogoProtoBufAConverter.From(&o.Records[i], &b.Records[i])
+		}
+	},
+	To: func(dst, src interface{}) {
+		b := dst.(*Batch)
+		o := src.(*GogoProtoBufBatch)
+		b.Records = make([]A, len(o.Records))
+		for i := range o.Records {
+			gogoProtoBufAConverter.To(&b.Records[i], &o.Records[i])
+		}
+	},
+}
+
+// github.com/pascaldekloe/colfer
+
+var colferBatchConverter = &Converter{
+	New: func() interface{} { return &ColferBatch{} },
+	From: func(dst, src interface{}) {
+		b := src.(*Batch)
+		o := dst.(*ColferBatch)
+		o.Records = make([]*ColferA, len(b.Records))
+		for i := range b.Records {
+			o.Records[i] = &ColferA{}
+			colferAConverter.From(o.Records[i], &b.Records[i])
+		}
+	},
+	To: func(dst, src interface{}) {
+		b := dst.(*Batch)
+		o := src.(*ColferBatch)
+		b.Records = make([]A, len(o.Records))
+		for i, r := range o.Records {
+			colferAConverter.To(&b.Records[i], r)
+		}
+	},
+}
+
+// github.com/andyleap/gencode
+
+var gencodeBatchConverter = &Converter{
+	New: func() interface{} { return &GencodeBatch{} },
+	From: func(dst, src interface{}) {
+		b := src.(*Batch)
+		o := dst.(*GencodeBatch)
+		o.Records = make([]GencodeA, len(b.Records))
+		for i := range b.Records {
+			gencodeAConverter.From(&o.Records[i], &b.Records[i])
+		}
+	},
+	To: func(dst, src interface{}) {
+		b := dst.(*Batch)
+		o := src.(*GencodeBatch)
+		b.Records = make([]A, len(o.Records))
+		for i := range o.Records {
+			gencodeAConverter.To(&b.Records[i], &o.Records[i])
+		}
+	},
+}
+
+var gencodeUnsafeBatchConverter = &Converter{
+	New: func() interface{} { return &GencodeUnsafeBatch{} },
+	From: func(dst, src interface{}) {
+		b := src.(*Batch)
+		o := dst.(*GencodeUnsafeBatch)
+		o.Records = make([]GencodeUnsafeA, len(b.Records))
+		for i := range b.Records {
+			gencodeUnsafeAConverter.From(&o.Records[i], &b.Records[i])
+		}
+	},
+	To: func(dst, src interface{}) {
+		b := dst.(*Batch)
+		o := src.(*GencodeUnsafeBatch)
+		b.Records = make([]A, len(o.Records))
+		for i := range o.Records {
+			gencodeUnsafeAConverter.To(&b.Records[i], &o.Records[i])
+		}
+	},
+}
+
+// github.com/calmh/xdr
+
+var xdrBatchConverter = &Converter{
+	New: func() interface{} { return &XDRBatch{} },
+	From: func(dst, src interface{}) {
+		b := src.(*Batch)
+		o := dst.(*XDRBatch)
+		o.Records = make([]XDRA, len(b.Records))
+		for i := range b.Records {
+			xdrAConverter.From(&o.Records[i], &b.Records[i])
+		}
+	},
+	To: func(dst, src interface{}) {
+		b := dst.(*Batch)
+		o := src.(*XDRBatch)
+		b.Records = make([]A, len(o.Records))
+		for i := range o.Records {
+			xdrAConverter.To(&b.Records[i], &o.Records[i])
+		}
+	},
+}
+
+// github.com/ikkerens/ikeapack
+
+type IkeBatch struct {
+	Records []IkeA
+}
+
+var ikeBatchConverter = &Converter{
+	New: func() interface{} { return &IkeBatch{} },
+	From: func(dst, src interface{}) {
+		b := src.(*Batch)
+		o := dst.(*IkeBatch)
+		o.Records = make([]IkeA, len(b.Records))
+		for i := range b.Records {
+			ikeAConverter.From(&o.Records[i], &b.Records[i])
+		}
+	},
+	To: func(dst, src interface{}) {
+		b := dst.(*Batch)
+		o := src.(*IkeBatch)
+		b.Records = make([]A, len(o.Records))
+		for i := range o.Records {
+			ikeAConverter.To(&b.Records[i], &o.Records[i])
+		}
+	},
+}
+
+// The Batch payload is registered for every serializer of A, under the same
+// names.
+func init() {
+	RegisterPayload(payloadBatch, map[string]Adaptation{
+		"Gotiny": {New: func() Serializer { return NewGotinySerializer(Batch{}) }},
+		"Gob":    {New: func() Serializer { return NewGobSerializer(Batch{}) }},
+		"FlatBuffers": {Converter: flatBufferConverter(func() Serializer {
+			return &FlatBufferBatchSerializer{builder: flatbuffers.NewBuilder(0)}
+		})},
+		"Goprotobuf":    {Converter: protoBufBatchConverter},
+		"Gogoprotobuf":  {Converter: gogoProtoBufBatchConverter},
+		"Colfer":        {Converter: colferBatchConverter},
+		"Gencode":       {Converter: gencodeBatchConverter},
+		"GencodeUnsafe": {Converter: gencodeUnsafeBatchConverter},
+		"XDR2":          {Converter: xdrBatchConverter},
+		"Ikea":          {Converter: ikeBatchConverter},
+	})
+}
@@ -0,0 +1,262 @@
+package goserbench
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/google/flatbuffers/go"
+)
+
+// blobSizes are the lengths of the Data of the Blob payloads, from a small
+// attachment up to a document or an image.
+var blobSizes = []int{1 << 10, 64 << 10, 1 << 20}
+
+// generateBlob returns records carrying size bytes of random data each.
+func generateBlob(size int) []*Blob {
+	r := newRand()
+	n := corpusLen(size)
+	p := make([]*Blob, 0, n)
+	for i := 0; i < n; i++ {
+		data := make([]byte, size)
+		r.Read(data)
+		p = append(p, &Blob{Name: randString(r, 16), Data: data})
+	}
+	return p
+}
+
+// blobPayload returns the Blob payload of the given data size, named after it,
+// e.g. Blob64K.
+func blobPayload(size int) *Payload {
+	name := fmt.Sprintf("Blob%dK", size>>10)
+	if size >= 1<<20 {
+		name = fmt.Sprintf("Blob%dM", size>>20)
+	}
+	return &Payload{
+		Name:     name,
+		Generate: func() []interface{} { return interfaces(generateBlob(size)) },
+		New:      func() interface{} { return &Blob{} },
+		Binary:   true,
+	}
+}
+
+// aliases reports whether any byte slice reachable from v points into buf.
+func aliases(v reflect.Value, buf []byte) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return !v.IsNil() && aliases(v.Elem(), buf)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if aliases(v.Field(i), buf) {
+				return true
+			}
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.Uint8 {
+			for i := 0; i < v.Len(); i++ {
+				if aliases(v.Index(i), buf) {
+					return true
+				}
+			}
+			return false
+		}
+		lo := reflect.ValueOf(buf).Pointer()
+		p := v.Pointer()
+		return v.Len() > 0 && p >= lo && p < lo+uintptr(len(buf))
+	}
+	return false
+}
+
+// reportAliasing decodes the first record of ser and reports as aliased
+// whether its byte slices point into the encoding rather than into memory of
+// their own. Such a decoder saves a copy, but what it returns is only valid as
+// long as the encoded message is neither modified nor reused.
+func reportAliasing(b *testing.B, info SerializerInfo, s Serializer, ser [][]byte) {
+	o := info.newValue()
+	if err := s.Unmarshal(ser[0], o); err != nil {
+		b.Fatalf("%s failed to unmarshal record 0: %s", info.Name, err)
+	}
+	var aliased float64
+	if aliases(reflect.ValueOf(o), ser[0]) {
+		aliased = 1
+	}
+	b.ReportMetric(aliased, "aliased")
+}
+
+// github.com/google/flatbuffers/go
+
+// FlatBufferBlobSerializer decodes Data with the generated accessor, which
+// returns a slice of the message instead of a copy.
+type FlatBufferBlobSerializer struct {
+	builder *flatbuffers.Builder
+}
+
+func (s *FlatBufferBlobSerializer) Marshal(o interface{}) ([]byte, error) {
+	a := o.(*Blob)
+	builder := s.builder
+
+	builder.Reset()
+
+	data := builder.CreateByteVector(a.Data)
+	name := builder.CreateString(a.Name)
+
+	FlatBufferBlobStart(builder)
+	FlatBufferBlobAddName(builder, name)
+	FlatBufferBlobAddData(builder, data)
+	builder.Finish(FlatBufferBlobEnd(builder))
+	return builder.Bytes[builder.Head():], nil
+}
+
+func (s *FlatBufferBlobSerializer) Unmarshal(d []byte, i interface{}) error {
+	a := i.(*Blob)
+	o := FlatBufferBlob{}
+	o.Init(d, flatbuffers.GetUOffsetT(d))
+	a.Name = string(o.Name())
+	a.Data = o.DataBytes()
+	return nil
+}
+
+func (s *FlatBufferBlobSerializer) String() string {
+	return "FlatBuffer"
+}
+
+// github.com/golang/protobuf
+
+var protoBufBlobConverter = &Converter{
+	New: func() interface{} { return &ProtoBufBlob{} },
+	From: func(dst, src interface{}) {
+		a := src.(*Blob)
+		*dst.(*ProtoBufBlob) = ProtoBufBlob{
+			Name: proto.String(a.Name),
+			Data: a.Data,
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*ProtoBufBlob)
+		*dst.(*Blob) = Blob{
+			Name: o.GetName(),
+			Data: o.GetData(),
+		}
+	},
+}
+
+// github.com/gogo/protobuf/proto
+
+var gogoProtoBufBlobConverter = &Converter{
+	New: func() interface{} { return &GogoProtoBufBlob{} },
+	From: func(dst, src interface{}) {
+		a := src.(*Blob)
+		*dst.(*GogoProtoBufBlob) = GogoProtoBufBlob{
+			Name: a.Name,
+			Data: a.Data,
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*GogoProtoBufBlob)
+		*dst.(*Blob) = Blob{
+			Name: o.Name,
+			Data: o.Data,
+		}
+	},
+}
+
+// github.com/pascaldekloe/colfer
+
+var colferBlobConverter = &Converter{
+	New: func() interface{} { return &ColferBlob{} },
+	From: func(dst, src interface{}) {
+		a := src.(*Blob)
+		*dst.(*ColferBlob) = ColferBlob{
+			Name: a.Name,
+			Data: a.Data,
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*ColferBlob)
+		*dst.(*Blob) = Blob{
+			Name: o.Name,
+			Data: o.Data,
+		}
+	},
+}
+
+// github.com/andyleap/gencode
+
+var gencodeBlobConverter = &Converter{
+	New: func() interface{} { return &GencodeBlob{} },
+	From: func(dst, src interface{}) {
+		a := src.(*Blob)
+		*dst.(*GencodeBlob) = GencodeBlob{
+			Name: a.Name,
+			Data: a.Data,
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*GencodeBlob)
+		*dst.(*Blob) = Blob{
+			Name: o.Name,
+			Data: o.Data,
+		}
+	},
+}
+
+var gencodeUnsafeBlobConverter = &Converter{
+	New: func() interface{} { return &GencodeUnsafeBlob{} },
+	From: func(dst, src interface{}) {
+		a := src.(*Blob)
+		*dst.(*GencodeUnsafeBlob) = GencodeUnsafeBlob{
+			Name: a.Name,
+			Data: a.Data,
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*GencodeUnsafeBlob)
+		*dst.(*Blob) = Blob{
+			Name: o.Name,
+			Data: o.Data,
+		}
+	},
+}
+
+// github.com/calmh/xdr
+
+var xdrBlobConverter = &Converter{
+	New: func() interface{} { return &XDRBlob{} },
+	From: func(dst, src interface{}) {
+		a := src.(*Blob)
+		*dst.(*XDRBlob) = XDRBlob{
+			Name: a.Name,
+			Data: a.Data,
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*XDRBlob)
+		*dst.(*Blob) = Blob{
+			Name: o.Name,
+			Data: o.Data,
+		}
+	},
+}
+
+// The Blob payloads are registered for every serializer of A, one payload per
+// size. Blob holds no field ikeapack cannot encode, so it needs no converter
+// there.
+func init() {
+	adapt := map[string]Adaptation{
+		"Gotiny": {New: func() Serializer { return NewGotinySerializer(Blob{}) }},
+		"Gob":    {New: func() Serializer { return NewGobSerializer(Blob{}) }},
+		"FlatBuffers": {Converter: flatBufferConverter(func() Serializer {
+			return &FlatBufferBlobSerializer{builder: flatbuffers.NewBuilder(0)}
+		})},
+		"Goprotobuf":    {Converter: protoBufBlobConverter},
+		"Gogoprotobuf":  {Converter: gogoProtoBufBlobConverter},
+		"Colfer":        {Converter: colferBlobConverter},
+		"Gencode":       {Converter: gencodeBlobConverter},
+		"GencodeUnsafe": {Converter: gencodeUnsafeBlobConverter},
+		"XDR2":          {Converter: xdrBlobConverter},
+	}
+	for _, size := range blobSizes {
+		RegisterPayload(blobPayload(size), adapt)
+	}
+}
@@ -0,0 +1,98 @@
+package goserbench
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"testing"
+)
+
+// indexSize is the length of the precomputed index sequences. It is a power
+// of two so that the timed loops pick the next record with a mask instead of
+// calling into math/rand.
+const (
+	indexSize = 1 << 14
+	indexMask = indexSize - 1
+)
+
+var indexCache sync.Map // corpus length -> []int
+
+// indexes returns a random sequence of record indexes into a corpus of n
+// records. It is derived from the corpus seed and shared by all benchmarks,
+// so every serializer visits the records in the same order.
+func indexes(n int) []int {
+	if idx, ok := indexCache.Load(n); ok {
+		return idx.([]int)
+	}
+	r := newRand()
+	idx := make([]int, indexSize)
+	for i := range idx {
+		idx[i] = r.Intn(n)
+	}
+	v, _ := indexCache.LoadOrStore(n, idx)
+	return v.([]int)
+}
+
+// noopSerializer does no work at all. Running the benchmark loops with it
+// measures what the harness itself costs per operation: picking a record,
+// the interface calls and allocating the decode target.
+type noopSerializer struct{}
+
+var noopMessage = []byte{0}
+
+func (noopSerializer) Marshal(o interface{}) ([]byte, error) { return noopMessage, nil }
+
+func (noopSerializer) MarshalTo(buf []byte, o interface{}) ([]byte, error) {
+	return noopMessage, nil
+}
+
+func (noopSerializer) Unmarshal(d []byte, o interface{}) error { return nil }
+
+func (noopSerializer) String() string { return "noop" }
+
+// baselineInfo is run as the Baseline sub-benchmark ahead of the serializers
+// registered for each payload. It is not registered itself, as its output
+// cannot be decoded.
+var baselineInfo = SerializerInfo{
+	Name:          "Baseline",
+	New:           func() Serializer { return noopSerializer{} },
+	GoroutineSafe: true,
+	baseline:      true,
+}
+
+var (
+	overheadMu sync.Mutex
+	overheads  = map[string]float64{}
+)
+
+// overheadKey identifies a benchmark loop of a payload. Allocating the decode
+// target costs differently for every payload, and parallel loops differently
+// depending on the number of goroutines, so both are part of the key.
+func overheadKey(info SerializerInfo, variant string) string {
+	return fmt.Sprintf("%s/%s-%d", info.payload().Name, variant, runtime.GOMAXPROCS(0))
+}
+
+// reportNet stops the timer and, when the Baseline sub-benchmark of the same
+// payload and variant has run before, reports the time per operation with the
+// harness overhead subtracted as net-ns/op, next to the raw ns/op. Run by the
+// Baseline itself, it records that overhead instead. For payloads batching
+// several records per message, it also reports the raw time per record.
+func reportNet(b *testing.B, info SerializerInfo, variant string) {
+	b.StopTimer()
+	if b.N == 0 {
+		return
+	}
+	raw := float64(b.Elapsed().Nanoseconds()) / float64(b.N)
+	if k := info.payload().PerMessage; k > 0 && !info.baseline {
+		b.ReportMetric(raw/float64(k), "ns/rec")
+	}
+	overheadMu.Lock()
+	defer overheadMu.Unlock()
+	if info.baseline {
+		overheads[overheadKey(info, variant)] = raw
+		return
+	}
+	if ns, ok := overheads[overheadKey(info, variant)]; ok {
+		b.ReportMetric(raw-ns, "net-ns/op")
+	}
+}
@@ -0,0 +1,192 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Verdicts of a comparison.
+const (
+	same       = "~"
+	better     = "better"
+	worse      = "worse"
+	regression = "REGRESSION"
+	untested   = "?"
+)
+
+// A summary is the runs of a benchmark on one side of a comparison.
+type summary struct {
+	Runs   int     `json:"runs"`
+	Mean   float64 `json:"mean"`
+	Stddev float64 `json:"stddev"`
+}
+
+func summarize(x []float64) summary {
+	mean, variance := meanVar(x)
+	return summary{Runs: len(x), Mean: mean, Stddev: math.Sqrt(variance)}
+}
+
+// A comparison is a benchmark or total of the candidate next to the same one
+// of the baseline.
+type comparison struct {
+	Name      string  `json:"name"`
+	Procs     int     `json:"procs"`
+	Unit      string  `json:"unit"`
+	Base      summary `json:"base"`
+	Candidate summary `json:"candidate"`
+	// Test is nil if either side ran less than twice.
+	Test    *welch `json:"test,omitempty"`
+	Verdict string `json:"verdict"`
+}
+
+func (c comparison) label() string {
+	return row{Name: c.Name, Procs: c.Procs}.label()
+}
+
+// lowerIsBetter reports whether a smaller value of unit is an improvement,
+// as for everything but throughput such as MB/s.
+func lowerIsBetter(unit string) bool {
+	return !strings.HasSuffix(unit, "/s")
+}
+
+// percent returns v as a percentage of base, infinite if only base is 0.
+func percent(v, base float64) float64 {
+	if v == 0 {
+		return 0
+	}
+	return 100 * v / math.Abs(base)
+}
+
+// compare compares the unit of every row of candidate with the row of the
+// same name in base, in the order of candidate. Rows on only one side are
+// left out. A difference is a regression if it is significant at alpha, for
+// the worse and larger than threshold percent of the baseline.
+func compare(base, candidate []row, unit string, alpha, threshold float64) []comparison {
+	index := map[rowKey]row{}
+	for _, r := range base {
+		index[rowKey{r.Name, r.Procs}] = r
+	}
+	var out []comparison
+	for _, r := range candidate {
+		b, ok := index[rowKey{r.Name, r.Procs}]
+		if !ok || len(b.samples[unit]) == 0 || len(r.samples[unit]) == 0 {
+			continue
+		}
+		c := comparison{
+			Name:      r.Name,
+			Procs:     r.Procs,
+			Unit:      unit,
+			Base:      summarize(b.samples[unit]),
+			Candidate: summarize(r.samples[unit]),
+			Verdict:   untested,
+		}
+		if w, ok := welchTest(b.samples[unit], r.samples[unit], alpha); ok {
+			c.Test = &w
+			delta := percent(w.Diff, c.Base.Mean)
+			if !lowerIsBetter(unit) {
+				delta = -delta
+			}
+			switch {
+			case w.P >= alpha:
+				c.Verdict = same
+			case delta < 0:
+				c.Verdict = better
+			case delta > threshold:
+				c.Verdict = regression
+			default:
+				c.Verdict = worse
+			}
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+// tally returns the number of comparisons with verdict.
+func tally(cs []comparison, verdict string) int {
+	n := 0
+	for _, c := range cs {
+		if c.Verdict == verdict {
+			n++
+		}
+	}
+	return n
+}
+
+func formatPercent(v float64) string {
+	return strconv.FormatFloat(v, 'f', 1, 64) + "%"
+}
+
+func formatDelta(v float64) string {
+	if v >= 0 {
+		return "+" + formatPercent(v)
+	}
+	return formatPercent(v)
+}
+
+// formatSummary formats s as its mean and its standard deviation relative
+// to it.
+func formatSummary(s summary) string {
+	return formatValue(s.Mean) + " ±" + formatPercent(math.Abs(percent(s.Stddev, s.Mean)))
+}
+
+// comparisonTable returns cs, which are all of the same unit, as a table of
+// their means and the difference in percent of the baseline, with its
+// confidence interval and p-value.
+func comparisonTable(cs []comparison, alpha float64) table {
+	ci := strconv.FormatFloat(100*(1-alpha), 'g', -1, 64) + "% CI"
+	unit := cs[0].Unit
+	t := table{header: []string{"benchmark", "base " + unit, "candidate " + unit, "delta", ci, "p", "verdict"}}
+	for _, c := range cs {
+		cells := []string{c.label(), formatSummary(c.Base), formatSummary(c.Candidate), "", "", "", c.Verdict}
+		if c.Test != nil {
+			m := c.Base.Mean
+			cells[3] = formatDelta(percent(c.Test.Diff, m))
+			cells[4] = "[" + formatDelta(percent(c.Test.Lo, m)) + ", " + formatDelta(percent(c.Test.Hi, m)) + "]"
+			cells[5] = strconv.FormatFloat(c.Test.P, 'f', 3, 64)
+		}
+		t.rows = append(t.rows, cells)
+	}
+	return t
+}
+
+func writeComparisonCSV(w io.Writer, cs []comparison) error {
+	c := csv.NewWriter(w)
+	c.Write([]string{"benchmark", "procs", "unit", "base runs", "base mean", "base stddev",
+		"candidate runs", "candidate mean", "candidate stddev", "diff", "lo", "hi", "p", "verdict"})
+	f := func(v float64) string { return strconv.FormatFloat(v, 'g', -1, 64) }
+	for _, x := range cs {
+		cells := []string{x.Name, strconv.Itoa(x.Procs), x.Unit,
+			strconv.Itoa(x.Base.Runs), f(x.Base.Mean), f(x.Base.Stddev),
+			strconv.Itoa(x.Candidate.Runs), f(x.Candidate.Mean), f(x.Candidate.Stddev),
+			"", "", "", "", x.Verdict}
+		if x.Test != nil {
+			cells[9], cells[10], cells[11], cells[12] = f(x.Test.Diff), f(x.Test.Lo), f(x.Test.Hi), f(x.Test.P)
+		}
+		c.Write(cells)
+	}
+	c.Flush()
+	return c.Error()
+}
+
+func writeComparisonJSON(w io.Writer, cs []comparison) error {
+	e := json.NewEncoder(w)
+	e.SetIndent("", "\t")
+	return e.Encode(cs)
+}
+
+// A regressionError is returned by run when the candidate regressed, for
+// benchreport to exit with 1 rather than 2 as on other errors.
+type regressionError struct {
+	n         int
+	threshold float64
+}
+
+func (e regressionError) Error() string {
+	return fmt.Sprintf("%d benchmarks regressed by more than %g%%", e.n, e.threshold)
+}
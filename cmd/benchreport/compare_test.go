@@ -0,0 +1,48 @@
+package main
+
+import "testing"
+
+func TestCompare(t *testing.T) {
+	rows := func(ns ...[]float64) []row {
+		var out []row
+		for i, x := range ns {
+			out = append(out, row{Name: string(rune('A' + i)), Procs: 1, samples: map[string][]float64{"ns/op": x}})
+		}
+		return out
+	}
+	base := rows(
+		[]float64{100, 101, 99, 100},
+		[]float64{100, 101, 99, 100},
+		[]float64{100, 101, 99, 100},
+		[]float64{100, 101, 99, 100},
+		[]float64{100},
+	)
+	candidate := rows(
+		[]float64{100, 99, 101, 100},  // the same
+		[]float64{90, 91, 89, 90},     // faster
+		[]float64{103, 104, 102, 103}, // slower, within the threshold
+		[]float64{120, 121, 119, 120}, // slower, beyond it
+		[]float64{200},                // a single run
+	)
+	cs := compare(base, candidate, "ns/op", 0.05, 5)
+	var got []string
+	for _, c := range cs {
+		got = append(got, c.Verdict)
+	}
+	want := []string{same, better, worse, regression, untested}
+	if len(got) != len(want) {
+		t.Fatalf("verdicts %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("verdicts %q, want %q", got, want)
+			break
+		}
+	}
+	if n := tally(cs, regression); n != 1 {
+		t.Errorf("%d regressions, want 1", n)
+	}
+	if n := tally(cs, untested); n != 1 {
+		t.Errorf("%d untested, want 1", n)
+	}
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// A table is a header and rows of cells, which writeText and
+// writeMarkdown align left in the first column and right in the others.
+type table struct {
+	header []string
+	rows   [][]string
+}
+
+// newTable returns a table of rows with a column per unit, and a column of
+// iterations if iterations is set. Missing metrics are left empty.
+func newTable(rows []row, units []string, iterations bool) table {
+	t := table{header: []string{"benchmark"}}
+	if iterations {
+		t.header = append(t.header, "iter")
+	}
+	t.header = append(t.header, units...)
+	for _, r := range rows {
+		cells := []string{r.label()}
+		if iterations {
+			cells = append(cells, strconv.FormatInt(r.N, 10))
+		}
+		for _, unit := range units {
+			v, ok := r.Metrics[unit]
+			if !ok {
+				cells = append(cells, "")
+				continue
+			}
+			cells = append(cells, formatValue(v))
+		}
+		t.rows = append(t.rows, cells)
+	}
+	return t
+}
+
+func (t table) widths() []int {
+	w := make([]int, len(t.header))
+	for _, cells := range append([][]string{t.header}, t.rows...) {
+		for i, c := range cells {
+			if n := utf8.RuneCountInString(c); n > w[i] {
+				w[i] = n
+			}
+		}
+	}
+	return w
+}
+
+func (t table) writeText(w io.Writer) {
+	widths := t.widths()
+	line := func(cells []string) {
+		var b strings.Builder
+		for i, c := range cells {
+			if i == 0 {
+				fmt.Fprintf(&b, "%-*s", widths[i], c)
+				continue
+			}
+			fmt.Fprintf(&b, "  %*s", widths[i], c)
+		}
+		fmt.Fprintln(w, strings.TrimRight(b.String(), " "))
+	}
+	line(t.header)
+	dashes := make([]string, len(t.header))
+	for i, n := range widths {
+		dashes[i] = strings.Repeat("-", n)
+	}
+	line(dashes)
+	for _, cells := range t.rows {
+		line(cells)
+	}
+}
+
+func (t table) writeMarkdown(w io.Writer) {
+	widths := t.widths()
+	line := func(cells []string) {
+		var b strings.Builder
+		b.WriteString("|")
+		for i, c := range cells {
+			if i == 0 {
+				fmt.Fprintf(&b, " %-*s |", widths[i], c)
+				continue
+			}
+			fmt.Fprintf(&b, " %*s |", widths[i], c)
+		}
+		fmt.Fprintln(w, b.String())
+	}
+	line(t.header)
+	align := make([]string, len(t.header))
+	for i, n := range widths {
+		align[i] = strings.Repeat("-", n-1) + ":"
+		if i == 0 {
+			align[i] = strings.Repeat("-", n)
+		}
+	}
+	fmt.Fprintf(w, "| %s |\n", strings.Join(align, " | "))
+	for _, cells := range t.rows {
+		line(cells)
+	}
+}
+
+// A report is what benchreport prints: the benchmarks in the order they ran
+// and the totals of their serializers in the order of -sort.
+type report struct {
+	Config     map[string]string `json:"config,omitempty"`
+	Benchmarks []row             `json:"benchmarks,omitempty"`
+	Totals     []row             `json:"totals"`
+}
+
+func (r *report) writeText(w io.Writer) {
+	if len(r.Benchmarks) > 0 {
+		newTable(r.Benchmarks, units(r.Benchmarks, false), true).writeText(w)
+		fmt.Fprintln(w, "---\ntotals:")
+	}
+	newTable(r.Totals, units(r.Totals, false), false).writeText(w)
+}
+
+func (r *report) writeMarkdown(w io.Writer) {
+	if len(r.Benchmarks) > 0 {
+		newTable(r.Benchmarks, units(r.Benchmarks, false), true).writeMarkdown(w)
+		fmt.Fprintln(w)
+	}
+	newTable(r.Totals, units(r.Totals, false), false).writeMarkdown(w)
+}
+
+// writeCSV writes the benchmarks and then the totals as one table, told
+// apart by its first column, with a column for every unit.
+func (r *report) writeCSV(w io.Writer) error {
+	all := units(append(append([]row(nil), r.Benchmarks...), r.Totals...), true)
+	c := csv.NewWriter(w)
+	c.Write(append([]string{"kind", "benchmark", "procs", "runs", "iter"}, all...))
+	write := func(kind string, rows []row) {
+		for _, r := range rows {
+			cells := []string{kind, r.Name, strconv.Itoa(r.Procs), strconv.Itoa(r.Runs), strconv.FormatInt(r.N, 10)}
+			for _, unit := range all {
+				v, ok := r.Metrics[unit]
+				if !ok {
+					cells = append(cells, "")
+					continue
+				}
+				cells = append(cells, strconv.FormatFloat(v, 'g', -1, 64))
+			}
+			c.Write(cells)
+		}
+	}
+	write("benchmark", r.Benchmarks)
+	write("total", r.Totals)
+	c.Flush()
+	return c.Error()
+}
+
+func (r *report) writeJSON(w io.Writer) error {
+	e := json.NewEncoder(w)
+	e.SetIndent("", "\t")
+	return e.Encode(r)
+}
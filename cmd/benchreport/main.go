@@ -0,0 +1,195 @@
+// Command benchreport turns the output of the benchmarks of this repository
+// into tables. It reads go test -bench output, plain or the events of
+// go test -json, from the files named on the command line or else from
+// standard input:
+//
+//	go test -bench='Serializers/' ./ | go run ./cmd/benchreport -sort size
+//	go test -bench='Serializers/' -json ./ > bench.json
+//	go run ./cmd/benchreport -format markdown bench.json
+//
+// It prints every benchmark, averaged over its runs if it ran more than once
+// with -count, followed by the total of the Marshal and Unmarshal of every
+// serializer but the Baseline, sorted by -sort.
+//
+// With -base it compares the output of a baseline, e.g. before upgrading a
+// library or Go, with that of the candidate instead. Both must come from at
+// least two runs with -count, or it cannot tell a change from noise and
+// exits with 2 after printing the comparison. For every benchmark and total
+// in both it prints the change of the -metric with a confidence interval and
+// the p-value of Welch's t-test, and it exits with 1 if any changed for the
+// worse, with significance -alpha, by more than -threshold percent:
+//
+//	go test -bench='Serializers/' -count 10 ./ > old.txt
+//	go get -u ./... && go test -bench='Serializers/' -count 10 ./ > new.txt
+//	go run ./cmd/benchreport -base old.txt new.txt
+//
+// Other errors exit with 2.
+//
+// With -bench it runs the benchmarks of the package in the current directory
+// itself rather than reading their output, and with -readme it replaces the
+// results between the <!-- results --> and <!-- /results --> markers of the
+// README with its Markdown tables, stamped with the Go version, GOOS/GOARCH,
+// CPU and the versions of the modules the benchmarks were built with:
+//
+//	go run ./cmd/benchreport -bench='^BenchmarkSerializers$' -count 5 -totals -readme README.md
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+var (
+	format = flag.String("format", "text", "output `format`: text, markdown, csv or json")
+	sortBy = flag.String("sort", "time", "comma-separated `keys` to sort the totals by: time, net, size, bytes, allocs, gc, name or a unit such as p99-ns; prefix a key with - to sort descending")
+	only   = flag.Bool("totals", false, "print only the totals")
+
+	base      = flag.String("base", "", "compare with the baseline in `file`; both it and the candidate need -count 2 or more")
+	metric    = flag.String("metric", "time", "`key` of the metric to compare, as for -sort")
+	alpha     = flag.Float64("alpha", 0.05, "significance level of the comparison; confidence intervals are at 1-alpha")
+	threshold = flag.Float64("threshold", 5, "`percent` of the baseline beyond which a significant change for the worse fails the comparison")
+
+	bench     = flag.String("bench", "", "run go test -bench with this `regexp` in the current directory instead of reading files")
+	count     = flag.Int("count", 1, "run the benchmarks of -bench `n` times")
+	benchtime = flag.String("benchtime", "", "run each benchmark of -bench for `t`, as go test -benchtime")
+	readme    = flag.String("readme", "", "replace the results in the README `file` instead of printing them")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: benchreport [flags] [file ...]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if err := run(os.Stdout, flag.Args()); err != nil {
+		fmt.Fprintln(os.Stderr, "benchreport:", err)
+		if errors.As(err, &regressionError{}) {
+			os.Exit(1)
+		}
+		os.Exit(2)
+	}
+}
+
+func run(w io.Writer, files []string) error {
+	switch *format {
+	case "text", "markdown", "csv", "json":
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+	keys, err := parseSortKeys(*sortBy)
+	if err != nil {
+		return err
+	}
+	var s *set
+	if *bench != "" {
+		s, err = runBenchmarks(*bench, *count, *benchtime)
+	} else {
+		s, err = readFiles(files)
+	}
+	if err != nil {
+		return err
+	}
+	if len(s.Results) == 0 {
+		return fmt.Errorf("no benchmark results")
+	}
+	r := newReport(s, keys)
+	if *base != "" {
+		return runCompare(w, r)
+	}
+	if *readme != "" {
+		return updateReadme(*readme, r, time.Now())
+	}
+	switch *format {
+	case "text":
+		r.writeText(w)
+	case "markdown":
+		r.writeMarkdown(w)
+	case "csv":
+		return r.writeCSV(w)
+	case "json":
+		return r.writeJSON(w)
+	}
+	return nil
+}
+
+// newReport aggregates the results of s into a report sorted by keys.
+func newReport(s *set, keys []sortKey) *report {
+	r := &report{Config: s.Config, Benchmarks: aggregate(s.Results)}
+	r.Totals = totals(r.Benchmarks)
+	sortRows(r.Totals, keys)
+	if *only {
+		r.Benchmarks = nil
+	}
+	return r
+}
+
+// runCompare compares candidate with the baseline of -base.
+func runCompare(w io.Writer, candidate *report) error {
+	keys, err := parseSortKeys(*metric)
+	if err != nil {
+		return err
+	}
+	if len(keys) != 1 || keys[0].unit == "" {
+		return fmt.Errorf("-metric %q is not a single metric", *metric)
+	}
+	s, err := readFiles([]string{*base})
+	if err != nil {
+		return err
+	}
+	b := newReport(s, nil)
+	unit := keys[0].unit
+	cs := compare(b.Benchmarks, candidate.Benchmarks, unit, *alpha, *threshold)
+	cs = append(cs, compare(b.Totals, candidate.Totals, unit, *alpha, *threshold)...)
+	if len(cs) == 0 {
+		return fmt.Errorf("no benchmark with %s in both the baseline and the candidate", unit)
+	}
+	switch *format {
+	case "text":
+		comparisonTable(cs, *alpha).writeText(w)
+	case "markdown":
+		comparisonTable(cs, *alpha).writeMarkdown(w)
+	case "csv":
+		err = writeComparisonCSV(w, cs)
+	case "json":
+		err = writeComparisonJSON(w, cs)
+	}
+	if err != nil {
+		return err
+	}
+	if n := tally(cs, untested); n > 0 {
+		return fmt.Errorf("%d of %d comparisons ran less than twice in the baseline or the candidate; run the benchmarks with -count 2 or more", n, len(cs))
+	}
+	if n := tally(cs, regression); n > 0 {
+		return regressionError{n, *threshold}
+	}
+	return nil
+}
+
+// readFiles parses the named files one after the other, or standard input if
+// there are none.
+func readFiles(files []string) (*set, error) {
+	if len(files) == 0 {
+		return parse(os.Stdin)
+	}
+	all := &set{Config: map[string]string{}}
+	for _, name := range files {
+		f, err := os.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		s, err := parse(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+		for k, v := range s.Config {
+			all.Config[k] = v
+		}
+		all.Results = append(all.Results, s.Results...)
+	}
+	return all, nil
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// A result is one line of benchmark output.
+type result struct {
+	// Name is the name of the benchmark without the Benchmark prefix and the
+	// GOMAXPROCS suffix, e.g. Serializers/Msgp/Marshal.
+	Name  string
+	Procs int
+	N     int64
+	// Metrics are the values by unit, e.g. ns/op, B/msg or net-ns/op.
+	Metrics map[string]float64
+}
+
+// A set is everything read from the output of one or more benchmark runs.
+type set struct {
+	// Config holds the goos, goarch, pkg and cpu lines go test prints before
+	// the results, and the go and modules lines of the benchmarks. A later
+	// value of a key replaces an earlier one.
+	Config  map[string]string
+	Results []result
+}
+
+// configKeys are the keys of the configuration lines printed by go test
+// and by printConfig of the benchmarks.
+var configKeys = map[string]bool{"goos": true, "goarch": true, "pkg": true, "cpu": true, "go": true, "modules": true}
+
+// event is the part of a go test -json event that carries output.
+type event struct {
+	Action  string
+	Package string
+	Output  string
+}
+
+// parse reads the output of go test -bench from r, plain or as the events of
+// go test -json, which may be mixed. Lines that are neither configuration
+// nor results are skipped.
+func parse(r io.Reader) (*set, error) {
+	s := &set{Config: map[string]string{}}
+	// The events of -json split benchmark lines between the name and the
+	// numbers, so their output is joined per package before parsing.
+	pending := map[string]string{}
+	var order []string
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for sc.Scan() {
+		line := sc.Text()
+		var e event
+		if strings.HasPrefix(line, "{") && json.Unmarshal([]byte(line), &e) == nil {
+			if e.Action != "output" {
+				continue
+			}
+			if _, ok := pending[e.Package]; !ok {
+				order = append(order, e.Package)
+			}
+			out := pending[e.Package] + e.Output
+			for {
+				i := strings.IndexByte(out, '\n')
+				if i < 0 {
+					break
+				}
+				s.parseLine(out[:i])
+				out = out[i+1:]
+			}
+			pending[e.Package] = out
+			continue
+		}
+		s.parseLine(line)
+	}
+	for _, p := range order {
+		s.parseLine(pending[p])
+	}
+	return s, sc.Err()
+}
+
+// parseLine adds line to s if it is a configuration line or a result.
+func (s *set) parseLine(line string) {
+	if i := strings.Index(line, ": "); i > 0 && configKeys[line[:i]] {
+		s.Config[line[:i]] = strings.TrimSpace(line[i+2:])
+		return
+	}
+	if r, ok := parseResult(line); ok {
+		s.Results = append(s.Results, r)
+	}
+}
+
+// parseResult parses a line of the form
+//
+//	BenchmarkName-8   1000   1234 ns/op   56 B/op   ...
+func parseResult(line string) (result, bool) {
+	f := strings.Fields(line)
+	if len(f) < 4 || len(f)%2 != 0 || !strings.HasPrefix(f[0], "Benchmark") {
+		return result{}, false
+	}
+	n, err := strconv.ParseInt(f[1], 10, 64)
+	if err != nil {
+		return result{}, false
+	}
+	r := result{Procs: 1, N: n, Metrics: map[string]float64{}}
+	r.Name = strings.TrimPrefix(f[0], "Benchmark")
+	if i := strings.LastIndexByte(r.Name, '-'); i > 0 {
+		if p, err := strconv.Atoi(r.Name[i+1:]); err == nil && p > 0 {
+			r.Name, r.Procs = r.Name[:i], p
+		}
+	}
+	for i := 2; i < len(f); i += 2 {
+		v, err := strconv.ParseFloat(f[i], 64)
+		if err != nil {
+			return result{}, false
+		}
+		r.Metrics[f[i+1]] = v
+	}
+	return r, true
+}
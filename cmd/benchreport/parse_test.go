@@ -0,0 +1,61 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const textOutput = `goos: linux
+goarch: amd64
+pkg: github.com/alecthomas/go_serialization_benchmarks
+cpu: Intel(R) Core(TM) i7-8550U CPU @ 1.80GHz
+BenchmarkSerializers/Msgp/Marshal-8         	 5000000	       171.2 ns/op	 566.65 MB/s	        97.00 B/msg	     128 B/op	       1 allocs/op
+BenchmarkSerializers/Msgp/Unmarshal-8       	 3000000	       299 ns/op	        97.00 B/msg	     112 B/op	       3 allocs/op
+    serialization_benchmarks_test.go:334: corpus seed 1
+BenchmarkSerializers/Msgp
+PASS
+`
+
+func TestParseText(t *testing.T) {
+	s, err := parse(strings.NewReader(textOutput))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Config["cpu"] != "Intel(R) Core(TM) i7-8550U CPU @ 1.80GHz" || s.Config["goarch"] != "amd64" {
+		t.Errorf("config %v", s.Config)
+	}
+	want := []result{
+		{"Serializers/Msgp/Marshal", 8, 5000000, map[string]float64{"ns/op": 171.2, "MB/s": 566.65, "B/msg": 97, "B/op": 128, "allocs/op": 1}},
+		{"Serializers/Msgp/Unmarshal", 8, 3000000, map[string]float64{"ns/op": 299, "B/msg": 97, "B/op": 112, "allocs/op": 3}},
+	}
+	if !reflect.DeepEqual(s.Results, want) {
+		t.Errorf("results\n%v\nwant\n%v", s.Results, want)
+	}
+}
+
+// TestParseJSON checks that lines split over the events of go test -json, as
+// benchmark results are, parse the same as the plain text.
+func TestParseJSON(t *testing.T) {
+	var b strings.Builder
+	for _, out := range []string{
+		`goos: linux\n`, `goarch: amd64\n`,
+		`pkg: github.com/alecthomas/go_serialization_benchmarks\n`,
+		`cpu: Intel(R) Core(TM) i7-8550U CPU @ 1.80GHz\n`,
+		`BenchmarkSerializers/Msgp/Marshal-8         \t`,
+		` 5000000\t       171.2 ns/op\t 566.65 MB/s\t        97.00 B/msg\t     128 B/op\t       1 allocs/op\n`,
+		`BenchmarkSerializers/Msgp/Unmarshal-8       \t`,
+		` 3000000\t       299 ns/op\t        97.00 B/msg\t     112 B/op\t       3 allocs/op\n`,
+	} {
+		b.WriteString(`{"Action":"output","Package":"p","Output":"` + out + `"}` + "\n")
+	}
+	b.WriteString(`{"Action":"pass","Package":"p"}` + "\n")
+	got, err := parse(strings.NewReader(b.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := parse(strings.NewReader(textOutput))
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got\n%v\nwant\n%v", got, want)
+	}
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The markers around the results in the README. Everything between them is
+// replaced by -readme.
+const (
+	resultsBegin = "<!-- results -->"
+	resultsEnd   = "<!-- /results -->"
+)
+
+// runBenchmarks runs the benchmarks matching pattern count times, for
+// benchtime unless it is empty, in the package in the current directory and
+// parses their output, which is also copied to stderr to show the progress.
+func runBenchmarks(pattern string, count int, benchtime string) (*set, error) {
+	args := []string{"test", "-run=^$", "-bench=" + pattern, "-count=" + strconv.Itoa(count)}
+	if benchtime != "" {
+		args = append(args, "-benchtime="+benchtime)
+	}
+	cmd := exec.Command("go", append(args, ".")...)
+	var out bytes.Buffer
+	cmd.Stdout = io.MultiWriter(&out, os.Stderr)
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("go test: %v", err)
+	}
+	return parse(&out)
+}
+
+// writeResults writes r as Markdown for the README: a line of where and
+// when it ran, the tables, and the versions of the modules.
+func (r *report) writeResults(w io.Writer, now time.Time) {
+	fmt.Fprintf(w, "Generated by `cmd/benchreport` on %s", now.Format("2006-01-02"))
+	if v := r.Config["go"]; v != "" {
+		fmt.Fprintf(w, " with %s", v)
+	}
+	if r.Config["goos"] != "" && r.Config["goarch"] != "" {
+		fmt.Fprintf(w, " on %s/%s", r.Config["goos"], r.Config["goarch"])
+	}
+	if v := r.Config["cpu"]; v != "" {
+		fmt.Fprintf(w, ", %s", v)
+	}
+	fmt.Fprint(w, ":\n\n")
+	r.writeMarkdown(w)
+	mods := strings.Fields(r.Config["modules"])
+	if len(mods) == 0 {
+		return
+	}
+	fmt.Fprint(w, "\n<details>\n<summary>Module versions</summary>\n\n")
+	for _, m := range mods {
+		m, replace, _ := strings.Cut(m, "=>")
+		path, version, _ := strings.Cut(m, "@")
+		fmt.Fprintf(w, "- `%s` %s", path, version)
+		if replace != "" {
+			fmt.Fprintf(w, ", replaced by `%s`", replace)
+		}
+		fmt.Fprintln(w)
+	}
+	fmt.Fprint(w, "\n</details>\n")
+}
+
+// updateReadme replaces the results between the markers of the README in
+// the file name with r.
+func updateReadme(name string, r *report, now time.Time) error {
+	old, err := os.ReadFile(name)
+	if err != nil {
+		return err
+	}
+	begin := bytes.Index(old, []byte(resultsBegin))
+	end := bytes.Index(old, []byte(resultsEnd))
+	if begin < 0 || end < begin {
+		return fmt.Errorf("%s: no %s and %s markers", name, resultsBegin, resultsEnd)
+	}
+	var b bytes.Buffer
+	b.Write(old[:begin+len(resultsBegin)])
+	b.WriteString("\n")
+	r.writeResults(&b, now)
+	b.Write(old[end:])
+	return os.WriteFile(name, b.Bytes(), 0o666)
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestUpdateReadme(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "README.md")
+	before := "# Title\n\n## Results\n\n" + resultsBegin + "\n"
+	after := resultsEnd + "\n\n## After\n"
+	if err := os.WriteFile(name, []byte(before+"old results\n"+after), 0o666); err != nil {
+		t.Fatal(err)
+	}
+	s, err := parse(strings.NewReader("go: go1.99\nmodules: github.com/tinylib/msgp@v1.1.0 example.com/stub@v0.0.0=>/tmp/stub\n" + textOutput))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := newReport(s, nil)
+	if err := updateReadme(name, r, time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatal(err)
+	}
+	b, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(b)
+	if !strings.HasPrefix(got, before) || !strings.HasSuffix(got, after) || strings.Contains(got, "old results") {
+		t.Errorf("results not replaced between the markers:\n%s", got)
+	}
+	for _, want := range []string{
+		"on 2026-10-18 with go1.99 on linux/amd64, Intel(R) Core(TM) i7-8550U CPU @ 1.80GHz:",
+		"| Serializers/Msgp-8 |",
+		"- `github.com/tinylib/msgp` v1.1.0\n",
+		"- `example.com/stub` v0.0.0, replaced by `/tmp/stub`\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %q in\n%s", want, got)
+		}
+	}
+	if err := updateReadme(filepath.Join(t.TempDir(), "missing.md"), r, time.Now()); err == nil {
+		t.Errorf("updated a missing README")
+	}
+}
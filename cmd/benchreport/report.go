@@ -0,0 +1,247 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// A row is a benchmark averaged over its runs, or the total of the Marshal
+// and Unmarshal of a serializer.
+type row struct {
+	Name  string `json:"name"`
+	Procs int    `json:"procs"`
+	Runs  int    `json:"runs"`
+	// N is the number of iterations of all runs together, 0 for totals.
+	N       int64              `json:"iterations,omitempty"`
+	Metrics map[string]float64 `json:"metrics"`
+	// samples are the values of every run by unit, which -base compares.
+	samples map[string][]float64
+}
+
+// label returns the name of r as go test prints it.
+func (r row) label() string {
+	if r.Procs > 1 {
+		return r.Name + "-" + strconv.Itoa(r.Procs)
+	}
+	return r.Name
+}
+
+type rowKey struct {
+	name  string
+	procs int
+}
+
+// aggregate returns a row per benchmark, in the order they first appear,
+// with every metric averaged over the runs that have it.
+func aggregate(results []result) []row {
+	index := map[rowKey]int{}
+	var rows []row
+	for _, r := range results {
+		k := rowKey{r.Name, r.Procs}
+		i, ok := index[k]
+		if !ok {
+			i = len(rows)
+			index[k] = i
+			rows = append(rows, row{Name: r.Name, Procs: r.Procs, Metrics: map[string]float64{}, samples: map[string][]float64{}})
+		}
+		rows[i].Runs++
+		rows[i].N += r.N
+		for unit, v := range r.Metrics {
+			rows[i].samples[unit] = append(rows[i].samples[unit], v)
+		}
+	}
+	for i := range rows {
+		for unit, x := range rows[i].samples {
+			rows[i].Metrics[unit], _ = meanVar(x)
+		}
+	}
+	return rows
+}
+
+// additive are the units a total sums over Marshal and Unmarshal. Sizes are
+// the same for both and taken from Marshal; percentiles do not add up and
+// are left out. The samples of a total are the sums of the runs in the
+// order they ran.
+var additive = []string{"ns/op", "net-ns/op", "B/op", "allocs/op", "gc/Mop", "gc-pause-ns/op"}
+
+// split splits a benchmark name into its serializer, e.g. Serializers/Msgp,
+// and its variant, e.g. Marshal.
+func split(name string) (prefix, variant string) {
+	i := strings.LastIndexByte(name, '/')
+	if i < 0 {
+		return "", name
+	}
+	return name[:i], name[i+1:]
+}
+
+// baseline is the name of the no-op serializer measuring the harness
+// overhead. Its rows are kept as a reference, but it is no serializer to
+// total and rank.
+const baseline = "Baseline"
+
+// totals returns the sum of the Marshal and Unmarshal rows of every
+// serializer but the Baseline that has both, named after the serializer, in
+// the order of its Marshal row. Variants such as MarshalReuse or RoundTrip
+// are not included.
+func totals(rows []row) []row {
+	unmarshal := map[rowKey]row{}
+	for _, r := range rows {
+		if prefix, variant := split(r.Name); variant == "Unmarshal" {
+			unmarshal[rowKey{prefix, r.Procs}] = r
+		}
+	}
+	var out []row
+	for _, m := range rows {
+		prefix, variant := split(m.Name)
+		if _, name := split(prefix); variant != "Marshal" || name == baseline {
+			continue
+		}
+		u, ok := unmarshal[rowKey{prefix, m.Procs}]
+		if !ok {
+			continue
+		}
+		t := row{Name: prefix, Procs: m.Procs, Runs: m.Runs, Metrics: map[string]float64{}, samples: map[string][]float64{}}
+		if u.Runs < t.Runs {
+			t.Runs = u.Runs
+		}
+		for _, unit := range additive {
+			mv, mok := m.Metrics[unit]
+			uv, uok := u.Metrics[unit]
+			if !mok || !uok {
+				continue
+			}
+			t.Metrics[unit] = mv + uv
+			if ms, us := m.samples[unit], u.samples[unit]; len(ms) == len(us) {
+				sum := make([]float64, len(ms))
+				for i := range ms {
+					sum[i] = ms[i] + us[i]
+				}
+				t.samples[unit] = sum
+			}
+		}
+		for _, r := range []row{u, m} {
+			if v, ok := r.Metrics["B/msg"]; ok {
+				t.Metrics["B/msg"] = v
+				t.samples["B/msg"] = r.samples["B/msg"]
+			}
+		}
+		out = append(out, t)
+	}
+	return out
+}
+
+// sortKeyUnits maps the names of sort keys to their unit. Any other key is
+// taken as a unit itself.
+var sortKeyUnits = map[string]string{
+	"time":   "ns/op",
+	"net":    "net-ns/op",
+	"size":   "B/msg",
+	"bytes":  "B/op",
+	"allocs": "allocs/op",
+	"gc":     "gc/Mop",
+	"name":   "",
+}
+
+type sortKey struct {
+	unit string // "" for the name
+	desc bool
+}
+
+// parseSortKeys parses a comma-separated list of sort keys, each prefixed
+// with - to sort descending.
+func parseSortKeys(s string) ([]sortKey, error) {
+	var keys []sortKey
+	for _, f := range strings.Split(s, ",") {
+		f = strings.TrimSpace(f)
+		k := sortKey{desc: strings.HasPrefix(f, "-")}
+		name := strings.TrimPrefix(f, "-")
+		if name == "" {
+			return nil, fmt.Errorf("empty sort key in %q", s)
+		}
+		unit, ok := sortKeyUnits[name]
+		if !ok {
+			unit = name
+		}
+		k.unit = unit
+		keys = append(keys, k)
+	}
+	return keys, nil
+}
+
+// sortRows sorts rows by keys, then by name and GOMAXPROCS so that rows
+// that tie keep a stable order. Rows without the metric of a key sort
+// after those with it.
+func sortRows(rows []row, keys []sortKey) {
+	less := func(a, b row) bool {
+		for _, k := range keys {
+			if k.unit == "" {
+				if a.Name != b.Name {
+					return (a.Name < b.Name) != k.desc
+				}
+				continue
+			}
+			av, aok := a.Metrics[k.unit]
+			bv, bok := b.Metrics[k.unit]
+			switch {
+			case aok != bok:
+				return aok
+			case !aok || av == bv:
+				continue
+			}
+			return (av < bv) != k.desc
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Procs < b.Procs
+	}
+	sort.SliceStable(rows, func(i, j int) bool { return less(rows[i], rows[j]) })
+}
+
+// shown are the units of the text and Markdown tables, in this order, where
+// any row has them. CSV and JSON carry every unit.
+var shown = []string{"ns/op", "net-ns/op", "B/op", "allocs/op", "B/msg", "p99-ns", "max-ns", "gc/Mop"}
+
+// units returns the units of shown that occur in rows, in their order, and
+// if all is set the other units of rows sorted by name.
+func units(rows []row, all bool) []string {
+	seen := map[string]bool{}
+	for _, r := range rows {
+		for unit := range r.Metrics {
+			seen[unit] = true
+		}
+	}
+	var out []string
+	for _, unit := range shown {
+		if seen[unit] {
+			out = append(out, unit)
+			delete(seen, unit)
+		}
+	}
+	if !all {
+		return out
+	}
+	var rest []string
+	for unit := range seen {
+		rest = append(rest, unit)
+	}
+	sort.Strings(rest)
+	return append(out, rest...)
+}
+
+// formatValue formats v with three significant digits below 100 and no
+// decimals above, as go test does.
+func formatValue(v float64) string {
+	switch a := math.Abs(v); {
+	case a == math.Trunc(a) || a >= 100:
+		return strconv.FormatFloat(v, 'f', 0, 64)
+	case a >= 10:
+		return strconv.FormatFloat(v, 'f', 1, 64)
+	case a >= 1:
+		return strconv.FormatFloat(v, 'f', 2, 64)
+	}
+	return strconv.FormatFloat(v, 'g', 3, 64)
+}
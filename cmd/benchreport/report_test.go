@@ -0,0 +1,67 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTotals(t *testing.T) {
+	results := []result{
+		{"Serializers/A/Marshal", 1, 100, map[string]float64{"ns/op": 10, "B/op": 8, "B/msg": 50}},
+		{"Serializers/A/MarshalReuse", 1, 100, map[string]float64{"ns/op": 5}},
+		{"Serializers/A/Unmarshal", 1, 100, map[string]float64{"ns/op": 20, "B/op": 16, "B/msg": 50}},
+		{"Serializers/A/Marshal", 1, 300, map[string]float64{"ns/op": 30, "B/op": 8, "B/msg": 50}},
+		{"Serializers/A/Unmarshal", 1, 300, map[string]float64{"ns/op": 20, "B/op": 16, "B/msg": 50}},
+		// Without an Unmarshal there is no total.
+		{"Serializers/B/Marshal", 1, 100, map[string]float64{"ns/op": 1}},
+		{"Serializers/C/Marshal", 1, 100, map[string]float64{"ns/op": 1, "B/msg": 10}},
+		{"Serializers/C/Unmarshal", 1, 100, map[string]float64{"ns/op": 39}},
+		// The Baseline is not a serializer.
+		{"Serializers/Baseline/Marshal", 1, 100, map[string]float64{"ns/op": 0.5}},
+		{"Serializers/Baseline/Unmarshal", 1, 100, map[string]float64{"ns/op": 0.5}},
+	}
+	got := totals(aggregate(results))
+	want := []row{
+		{Name: "Serializers/A", Procs: 1, Runs: 2, Metrics: map[string]float64{"ns/op": 40, "B/op": 24, "B/msg": 50},
+			samples: map[string][]float64{"ns/op": {30, 50}, "B/op": {24, 24}, "B/msg": {50, 50}}},
+		{Name: "Serializers/C", Procs: 1, Runs: 1, Metrics: map[string]float64{"ns/op": 40, "B/msg": 10},
+			samples: map[string][]float64{"ns/op": {40}, "B/msg": {10}}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("totals\n%v\nwant\n%v", got, want)
+	}
+}
+
+// TestSortRows checks that rows tying on the sort keys, which stats.sh let
+// overwrite each other, are all kept and ordered by name.
+func TestSortRows(t *testing.T) {
+	rows := []row{
+		{Name: "D", Metrics: map[string]float64{"ns/op": 40}},
+		{Name: "C", Metrics: map[string]float64{"ns/op": 40, "B/msg": 10}},
+		{Name: "B", Metrics: map[string]float64{"ns/op": 40, "B/msg": 20}},
+		{Name: "A", Metrics: map[string]float64{"ns/op": 10, "B/msg": 10}},
+	}
+	for _, c := range []struct {
+		keys string
+		want string
+	}{
+		{"time", "ABCD"},
+		{"size", "ACBD"},
+		{"-size,time", "BACD"},
+		{"time,-size", "ABCD"},
+		{"-name", "DCBA"},
+	} {
+		keys, err := parseSortKeys(c.keys)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sortRows(rows, keys)
+		var got string
+		for _, r := range rows {
+			got += r.Name
+		}
+		if got != c.want {
+			t.Errorf("sorted by %s: %s, want %s", c.keys, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package main
+
+import "math"
+
+// meanVar returns the mean and the sample variance of x.
+func meanVar(x []float64) (mean, variance float64) {
+	for _, v := range x {
+		mean += v
+	}
+	mean /= float64(len(x))
+	if len(x) < 2 {
+		return mean, 0
+	}
+	for _, v := range x {
+		variance += (v - mean) * (v - mean)
+	}
+	return mean, variance / float64(len(x)-1)
+}
+
+// A welch is the outcome of Welch's t-test of the means of two samples, which
+// unlike Student's does not assume they have the same variance.
+type welch struct {
+	// Diff is the mean of the second sample minus that of the first, Lo and
+	// Hi the bounds of its confidence interval.
+	Diff float64 `json:"diff"`
+	Lo   float64 `json:"lo"`
+	Hi   float64 `json:"hi"`
+	// P is the two-tailed probability of a difference at least as large if
+	// the means were the same.
+	P float64 `json:"p"`
+}
+
+// welchTest tests the difference of the means of a and b and returns its
+// confidence interval at level 1-alpha. It needs two values in each sample.
+func welchTest(a, b []float64, alpha float64) (welch, bool) {
+	if len(a) < 2 || len(b) < 2 {
+		return welch{}, false
+	}
+	ma, va := meanVar(a)
+	mb, vb := meanVar(b)
+	w := welch{Diff: mb - ma}
+	sa, sb := va/float64(len(a)), vb/float64(len(b))
+	se := math.Sqrt(sa + sb)
+	if se == 0 {
+		// Constant samples, as allocs/op mostly are: any difference is
+		// certain.
+		w.Lo, w.Hi, w.P = w.Diff, w.Diff, 1
+		if w.Diff != 0 {
+			w.P = 0
+		}
+		return w, true
+	}
+	// The Welch-Satterthwaite approximation of the degrees of freedom.
+	df := (sa + sb) * (sa + sb) / (sa*sa/float64(len(a)-1) + sb*sb/float64(len(b)-1))
+	w.P = tTail(w.Diff/se, df)
+	t := tQuantile(alpha, df)
+	w.Lo, w.Hi = w.Diff-t*se, w.Diff+t*se
+	return w, true
+}
+
+// tTail returns the probability that |T| >= |t| for T of Student's t
+// distribution with df degrees of freedom.
+func tTail(t, df float64) float64 {
+	return betaInc(df/2, 0.5, df/(df+t*t))
+}
+
+// tQuantile returns the t >= 0 for which tTail(t, df) is p, by bisection.
+func tQuantile(p, df float64) float64 {
+	lo, hi := 0.0, 1.0
+	for tTail(hi, df) > p {
+		lo, hi = hi, 2*hi
+	}
+	for i := 0; i < 100; i++ {
+		mid := (lo + hi) / 2
+		if tTail(mid, df) > p {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return (lo + hi) / 2
+}
+
+// betaInc returns the regularized incomplete beta function I_x(a, b),
+// evaluated by its continued fraction as in Numerical Recipes 6.4.
+func betaInc(a, b, x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	if x >= 1 {
+		return 1
+	}
+	la, _ := math.Lgamma(a)
+	lb, _ := math.Lgamma(b)
+	lab, _ := math.Lgamma(a + b)
+	front := math.Exp(lab - la - lb + a*math.Log(x) + b*math.Log(1-x))
+	// The fraction converges fast only on this side of the mean; the other
+	// side follows from I_x(a, b) = 1 - I_1-x(b, a).
+	if x < (a+1)/(a+b+2) {
+		return front * betaFraction(a, b, x) / a
+	}
+	return 1 - front*betaFraction(b, a, 1-x)/b
+}
+
+// betaFraction evaluates the continued fraction of betaInc by the modified
+// Lentz method.
+func betaFraction(a, b, x float64) float64 {
+	const (
+		epsilon = 1e-15
+		tiny    = 1e-300
+	)
+	clamp := func(v float64) float64 {
+		if math.Abs(v) < tiny {
+			return tiny
+		}
+		return v
+	}
+	c, d := 1.0, 1/clamp(1-(a+b)*x/(a+1))
+	h := d
+	for m := 1.0; m <= 300; m++ {
+		num := m * (b - m) * x / ((a + 2*m - 1) * (a + 2*m))
+		d = 1 / clamp(1+num*d)
+		c = clamp(1 + num/c)
+		h *= d * c
+		num = -(a + m) * (a + b + m) * x / ((a + 2*m) * (a + 2*m + 1))
+		d = 1 / clamp(1+num*d)
+		c = clamp(1 + num/c)
+		step := d * c
+		h *= step
+		if math.Abs(step-1) < epsilon {
+			break
+		}
+	}
+	return h
+}
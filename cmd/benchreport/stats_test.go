@@ -0,0 +1,49 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+// TestTQuantile checks two-tailed 5% and 1% critical values of Student's t
+// distribution against the tables.
+func TestTQuantile(t *testing.T) {
+	for _, c := range []struct {
+		p, df, t float64
+	}{
+		{0.05, 1, 12.706},
+		{0.05, 4, 2.776},
+		{0.05, 10, 2.228},
+		{0.05, 30, 2.042},
+		{0.01, 10, 3.169},
+		{0.05, 1e6, 1.960},
+	} {
+		if got := tQuantile(c.p, c.df); math.Abs(got-c.t) > 0.001 {
+			t.Errorf("tQuantile(%g, %g) = %.4f, want %.3f", c.p, c.df, got, c.t)
+		}
+		if got := tTail(c.t, c.df); math.Abs(got-c.p) > c.p/100 {
+			t.Errorf("tTail(%g, %g) = %.5f, want %g", c.t, c.df, got, c.p)
+		}
+	}
+}
+
+func TestWelchTest(t *testing.T) {
+	a := []float64{27.5, 21.0, 19.0, 23.6, 17.0, 17.9, 16.9, 20.1, 21.9, 22.6, 23.1, 19.6, 19.0, 21.7, 21.4}
+	b := []float64{27.1, 22.0, 20.8, 23.4, 23.4, 23.5, 25.8, 22.0, 24.8, 20.2, 21.9, 22.1, 22.9, 20.5, 24.4}
+	w, ok := welchTest(a, b, 0.05)
+	if !ok {
+		t.Fatal("not tested")
+	}
+	// t = 2.4554 at 24.99 degrees of freedom.
+	if math.Abs(w.Diff-2.1667) > 1e-4 || math.Abs(w.P-0.02138) > 1e-5 ||
+		math.Abs(w.Lo-0.3492) > 1e-4 || math.Abs(w.Hi-3.9841) > 1e-4 {
+		t.Errorf("got %+v", w)
+	}
+	if _, ok := welchTest(a[:1], b, 0.05); ok {
+		t.Errorf("tested a single run")
+	}
+	w, _ = welchTest([]float64{3, 3}, []float64{4, 4, 4}, 0.05)
+	if w.P != 0 || w.Lo != 1 || w.Hi != 1 {
+		t.Errorf("constant samples: got %+v", w)
+	}
+}
@@ -0,0 +1,417 @@
+package goserbench
+
+import (
+	"encoding/gob"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/google/flatbuffers/go"
+)
+
+// generateEvents returns events of the three kinds in even shares, as a
+// click stream with the occasional purchase or signup would not have them.
+// Kinds follow each other at random so that no serializer can settle on one.
+func generateEvents() []*Event {
+	r := newRand()
+	e := make([]*Event, corpusSize)
+	for i := range e {
+		ev := &Event{ID: r.Int63()}
+		switch r.Intn(3) {
+		case 0:
+			ev.Body = &Click{
+				Page: "/" + randString(r, 12),
+				X:    r.Intn(1920),
+				Y:    r.Intn(1080),
+			}
+		case 1:
+			ev.Body = &Purchase{
+				Item:     randString(r, 8),
+				Quantity: 1 + r.Intn(10),
+				Price:    r.Float64() * 100,
+			}
+		default:
+			ev.Body = &Signup{
+				Name:     randString(r, 16),
+				Email:    randString(r, 10) + "@example.com",
+				Referrer: randString(r, 8),
+			}
+		}
+		e[i] = ev
+	}
+	return e
+}
+
+var payloadEvent = &Payload{
+	Name:     "Event",
+	Generate: func() []interface{} { return interfaces(generateEvents()) },
+	New:      func() interface{} { return &Event{} },
+}
+
+// Gob writes the concrete type of an interface value by the name it was
+// registered under.
+func init() {
+	gob.Register(&Click{})
+	gob.Register(&Purchase{})
+	gob.Register(&Signup{})
+}
+
+// newEventGobSerializer returns a gob serializer for Event. Gob sends the
+// type of an interface value along with the first message holding it, which
+// a decoder reading the messages out of order would miss, so one event of
+// each kind goes through up front.
+func newEventGobSerializer() *GobSerializer {
+	s := NewGobSerializer(Event{})
+	for _, body := range []EventBody{&Click{}, &Purchase{}, &Signup{}} {
+		if err := s.enc.Encode(&Event{Body: body}); err != nil {
+			panic(err)
+		}
+		if err := s.dec.Decode(&Event{}); err != nil {
+			panic(err)
+		}
+	}
+	return s
+}
+
+// The serializers without unions or interface types encode an
+// EventEnvelope, with the body in the field of its type.
+var eventEnvelopeConverter = &Converter{
+	New: func() interface{} { return &EventEnvelope{} },
+	From: func(dst, src interface{}) {
+		e := src.(*Event)
+		o := dst.(*EventEnvelope)
+		*o = EventEnvelope{ID: e.ID}
+		switch b := e.Body.(type) {
+		case *Click:
+			o.Click = b
+		case *Purchase:
+			o.Purchase = b
+		case *Signup:
+			o.Signup = b
+		}
+	},
+	To: func(dst, src interface{}) {
+		e := dst.(*Event)
+		o := src.(*EventEnvelope)
+		*e = Event{ID: o.ID}
+		switch {
+		case o.Click != nil:
+			e.Body = o.Click
+		case o.Purchase != nil:
+			e.Body = o.Purchase
+		case o.Signup != nil:
+			e.Body = o.Signup
+		}
+	},
+}
+
+// github.com/google/flatbuffers/go
+
+type FlatBufferEventSerializer struct {
+	builder *flatbuffers.Builder
+}
+
+func (s *FlatBufferEventSerializer) Marshal(o interface{}) ([]byte, error) {
+	e := o.(*Event)
+	builder := s.builder
+
+	builder.Reset()
+
+	var body flatbuffers.UOffsetT
+	var bodyType byte
+	switch b := e.Body.(type) {
+	case *Click:
+		page := builder.CreateString(b.Page)
+		FlatBufferClickStart(builder)
+		FlatBufferClickAddPage(builder, page)
+		FlatBufferClickAddX(builder, int32(b.X))
+		FlatBufferClickAddY(builder, int32(b.Y))
+		body, bodyType = FlatBufferClickEnd(builder), FlatBufferEventBodyFlatBufferClick
+	case *Purchase:
+		item := builder.CreateString(b.Item)
+		FlatBufferPurchaseStart(builder)
+		FlatBufferPurchaseAddItem(builder, item)
+		FlatBufferPurchaseAddQuantity(builder, int32(b.Quantity))
+		FlatBufferPurchaseAddPrice(builder, b.Price)
+		body, bodyType = FlatBufferPurchaseEnd(builder), FlatBufferEventBodyFlatBufferPurchase
+	case *Signup:
+		name := builder.CreateString(b.Name)
+		email := builder.CreateString(b.Email)
+		referrer := builder.CreateString(b.Referrer)
+		FlatBufferSignupStart(builder)
+		FlatBufferSignupAddName(builder, name)
+		FlatBufferSignupAddEmail(builder, email)
+		FlatBufferSignupAddReferrer(builder, referrer)
+		body, bodyType = FlatBufferSignupEnd(builder), FlatBufferEventBodyFlatBufferSignup
+	}
+
+	FlatBufferEventStart(builder)
+	FlatBufferEventAddId(builder, e.ID)
+	if bodyType != FlatBufferEventBodyNONE {
+		FlatBufferEventAddBodyType(builder, bodyType)
+		FlatBufferEventAddBody(builder, body)
+	}
+	builder.Finish(FlatBufferEventEnd(builder))
+	return builder.Bytes[builder.Head():], nil
+}
+
+func (s *FlatBufferEventSerializer) Unmarshal(d []byte, i interface{}) error {
+	e := i.(*Event)
+	o := FlatBufferEvent{}
+	o.Init(d, flatbuffers.GetUOffsetT(d))
+	*e = Event{ID: o.Id()}
+	var t flatbuffers.Table
+	if !o.Body(&t) {
+		return nil
+	}
+	switch o.BodyType() {
+	case FlatBufferEventBodyFlatBufferClick:
+		var b FlatBufferClick
+		b.Init(t.Bytes, t.Pos)
+		e.Body = &Click{Page: string(b.Page()), X: int(b.X()), Y: int(b.Y())}
+	case FlatBufferEventBodyFlatBufferPurchase:
+		var b FlatBufferPurchase
+		b.Init(t.Bytes, t.Pos)
+		e.Body = &Purchase{Item: string(b.Item()), Quantity: int(b.Quantity()), Price: b.Price()}
+	case FlatBufferEventBodyFlatBufferSignup:
+		var b FlatBufferSignup
+		b.Init(t.Bytes, t.Pos)
+		e.Body = &Signup{Name: string(b.Name()), Email: string(b.Email()), Referrer: string(b.Referrer())}
+	}
+	return nil
+}
+
+func (s *FlatBufferEventSerializer) String() string {
+	return "FlatBuffer"
+}
+
+// github.com/golang/protobuf
+
+var protoBufEventConverter = &Converter{
+	New: func() interface{} { return &ProtoBufEvent{} },
+	From: func(dst, src interface{}) {
+		e := src.(*Event)
+		o := dst.(*ProtoBufEvent)
+		*o = ProtoBufEvent{Id: proto.Int64(e.ID)}
+		switch b := e.Body.(type) {
+		case *Click:
+			o.Body = &ProtoBufEvent_Click{&ProtoBufClick{
+				Page: proto.String(b.Page),
+				X:    proto.Int32(int32(b.X)),
+				Y:    proto.Int32(int32(b.Y)),
+			}}
+		case *Purchase:
+			o.Body = &ProtoBufEvent_Purchase{&ProtoBufPurchase{
+				Item:     proto.String(b.Item),
+				Quantity: proto.Int32(int32(b.Quantity)),
+				Price:    proto.Float64(b.Price),
+			}}
+		case *Signup:
+			o.Body = &ProtoBufEvent_Signup{&ProtoBufSignup{
+				Name:     proto.String(b.Name),
+				Email:    proto.String(b.Email),
+				Referrer: proto.String(b.Referrer),
+			}}
+		}
+	},
+	To: func(dst, src interface{}) {
+		e := dst.(*Event)
+		o := src.(*ProtoBufEvent)
+		*e = Event{ID: o.GetId()}
+		switch b := o.Body.(type) {
+		case *ProtoBufEvent_Click:
+			e.Body = &Click{
+				Page: b.Click.GetPage(),
+				X:    int(b.Click.GetX()),
+				Y:    int(b.Click.GetY()),
+			}
+		case *ProtoBufEvent_Purchase:
+			e.Body = &Purchase{
+				Item:     b.Purchase.GetItem(),
+				Quantity: int(b.Purchase.GetQuantity()),
+				Price:    b.Purchase.GetPrice(),
+			}
+		case *ProtoBufEvent_Signup:
+			e.Body = &Signup{
+				Name:     b.Signup.GetName(),
+				Email:    b.Signup.GetEmail(),
+				Referrer: b.Signup.GetReferrer(),
+			}
+		}
+	},
+}
+
+// github.com/gogo/protobuf/proto
+
+var gogoProtoBufEventConverter = &Converter{
+	New: func() interface{} { return &GogoProtoBufEvent{} },
+	From: func(dst, src interface{}) {
+		e := src.(*Event)
+		o := dst.(*GogoProtoBufEvent)
+		*o = GogoProtoBufEvent{Id: e.ID}
+		switch b := e.Body.(type) {
+		case *Click:
+			o.Body = &GogoProtoBufEvent_Click{&GogoProtoBufClick{
+				Page: b.Page,
+				X:    int32(b.X),
+				Y:    int32(b.Y),
+			}}
+		case *Purchase:
+			o.Body = &GogoProtoBufEvent_Purchase{&GogoProtoBufPurchase{
+				Item:     b.Item,
+				Quantity: int32(b.Quantity),
+				Price:    b.Price,
+			}}
+		case *Signup:
+			o.Body = &GogoProtoBufEvent_Signup{&GogoProtoBufSignup{
+				Name:     b.Name,
+				Email:    b.Email,
+				Referrer: b.Referrer,
+			}}
+		}
+	},
+	To: func(dst, src interface{}) {
+		e := dst.(*Event)
+		o := src.(*GogoProtoBufEvent)
+		*e = Event{ID: o.Id}
+		switch b := o.Body.(type) {
+		case *GogoProtoBufEvent_Click:
+			e.Body = &Click{Page: b.Click.Page, X: int(b.Click.X), Y: int(b.Click.Y)}
+		case *GogoProtoBufEvent_Purchase:
+			e.Body = &Purchase{Item: b.Purchase.Item, Quantity: int(b.Purchase.Quantity), Price: b.Purchase.Price}
+		case *GogoProtoBufEvent_Signup:
+			e.Body = &Signup{Name: b.Signup.Name, Email: b.Signup.Email, Referrer: b.Signup.Referrer}
+		}
+	},
+}
+
+// github.com/pascaldekloe/colfer
+
+// Colfer has no unions, so ColferEvent sets one of its optional struct
+// fields, the way EventEnvelope does.
+var colferEventConverter = &Converter{
+	New: func() interface{} { return &ColferEvent{} },
+	From: func(dst, src interface{}) {
+		e := src.(*Event)
+		o := dst.(*ColferEvent)
+		*o = ColferEvent{ID: e.ID}
+		switch b := e.Body.(type) {
+		case *Click:
+			o.Click = &ColferClick{Page: b.Page, X: int32(b.X), Y: int32(b.Y)}
+		case *Purchase:
+			o.Purchase = &ColferPurchase{Item: b.Item, Quantity: int32(b.Quantity), Price: b.Price}
+		case *Signup:
+			o.Signup = &ColferSignup{Name: b.Name, Email: b.Email, Referrer: b.Referrer}
+		}
+	},
+	To: func(dst, src interface{}) {
+		e := dst.(*Event)
+		o := src.(*ColferEvent)
+		*e = Event{ID: o.ID}
+		switch {
+		case o.Click != nil:
+			e.Body = &Click{Page: o.Click.Page, X: int(o.Click.X), Y: int(o.Click.Y)}
+		case o.Purchase != nil:
+			e.Body = &Purchase{Item: o.Purchase.Item, Quantity: int(o.Purchase.Quantity), Price: o.Purchase.Price}
+		case o.Signup != nil:
+			e.Body = &Signup{Name: o.Signup.Name, Email: o.Signup.Email, Referrer: o.Signup.Referrer}
+		}
+	},
+}
+
+// github.com/andyleap/gencode
+
+// Gencode has no unions either; GencodeEvent has a pointer per kind, which
+// it writes as a presence byte followed by the value if set.
+var gencodeEventConverter = &Converter{
+	New: func() interface{} { return &GencodeEvent{} },
+	From: func(dst, src interface{}) {
+		e := src.(*Event)
+		o := dst.(*GencodeEvent)
+		*o = GencodeEvent{ID: e.ID}
+		switch b := e.Body.(type) {
+		case *Click:
+			o.Click = &GencodeClick{Page: b.Page, X: int64(b.X), Y: int64(b.Y)}
+		case *Purchase:
+			o.Purchase = &GencodePurchase{Item: b.Item, Quantity: int64(b.Quantity), Price: b.Price}
+		case *Signup:
+			o.Signup = &GencodeSignup{Name: b.Name, Email: b.Email, Referrer: b.Referrer}
+		}
+	},
+	To: func(dst, src interface{}) {
+		e := dst.(*Event)
+		o := src.(*GencodeEvent)
+		*e = Event{ID: o.ID}
+		switch {
+		case o.Click != nil:
+			e.Body = &Click{Page: o.Click.Page, X: int(o.Click.X), Y: int(o.Click.Y)}
+		case o.Purchase != nil:
+			e.Body = &Purchase{Item: o.Purchase.Item, Quantity: int(o.Purchase.Quantity), Price: o.Purchase.Price}
+		case o.Signup != nil:
+			e.Body = &Signup{Name: o.Signup.Name, Email: o.Signup.Email, Referrer: o.Signup.Referrer}
+		}
+	},
+}
+
+var gencodeUnsafeEventConverter = &Converter{
+	New: func() interface{} { return &GencodeUnsafeEvent{} },
+	From: func(dst, src interface{}) {
+		e := src.(*Event)
+		o := dst.(*GencodeUnsafeEvent)
+		*o = GencodeUnsafeEvent{ID: e.ID}
+		switch b := e.Body.(type) {
+		case *Click:
+			o.Click = &GencodeUnsafeClick{Page: b.Page, X: int64(b.X), Y: int64(b.Y)}
+		case *Purchase:
+			o.Purchase = &GencodeUnsafePurchase{Item: b.Item, Quantity: int64(b.Quantity), Price: b.Price}
+		case *Signup:
+			o.Signup = &GencodeUnsafeSignup{Name: b.Name, Email: b.Email, Referrer: b.Referrer}
+		}
+	},
+	To: func(dst, src interface{}) {
+		e := dst.(*Event)
+		o := src.(*GencodeUnsafeEvent)
+		*e = Event{ID: o.ID}
+		switch {
+		case o.Click != nil:
+			e.Body = &Click{Page: o.Click.Page, X: int(o.Click.X), Y: int(o.Click.Y)}
+		case o.Purchase != nil:
+			e.Body = &Purchase{Item: o.Purchase.Item, Quantity: int(o.Purchase.Quantity), Price: o.Purchase.Price}
+		case o.Signup != nil:
+			e.Body = &Signup{Name: o.Signup.Name, Email: o.Signup.Email, Referrer: o.Signup.Referrer}
+		}
+	},
+}
+
+// Gob, Goprotobuf, Gogoprotobuf and FlatBuffers carry the union natively;
+// all others go through eventEnvelopeConverter or, for Colfer and Gencode,
+// an envelope of generated types. XDR2 and Ikea skip the payload.
+func init() {
+	RegisterPayload(payloadEvent, map[string]Adaptation{
+		"Gotiny": {
+			New:       func() Serializer { return NewGotinySerializer(EventEnvelope{}) },
+			Converter: eventEnvelopeConverter,
+		},
+		"Msgp":               {Converter: eventEnvelopeConverter},
+		"VmihailencoMsgpack": {Converter: eventEnvelopeConverter},
+		"Json":               {Converter: eventEnvelopeConverter},
+		"JsonIter":           {Converter: eventEnvelopeConverter},
+		"EasyJson":           {Converter: eventEnvelopeConverter},
+		"Bson":               {Converter: eventEnvelopeConverter},
+		"Gob":                {New: func() Serializer { return newEventGobSerializer() }},
+		"UgorjiCodecMsgpack": {Converter: eventEnvelopeConverter},
+		"UgorjiCodecBinc":    {Converter: eventEnvelopeConverter},
+		"FlatBuffers": {Converter: flatBufferConverter(func() Serializer {
+			return &FlatBufferEventSerializer{builder: flatbuffers.NewBuilder(0)}
+		})},
+		"Protobuf":      {Converter: eventEnvelopeConverter},
+		"Goprotobuf":    {Converter: protoBufEventConverter},
+		"Gogoprotobuf":  {Converter: gogoProtoBufEventConverter},
+		"Colfer":        {Converter: colferEventConverter},
+		"Gencode":       {Converter: gencodeEventConverter},
+		"GencodeUnsafe": {Converter: gencodeUnsafeEventConverter},
+		"XDR2": {Skip: "the XDR format has discriminated unions and optional data, " +
+			"but github.com/calmh/xdr supports neither"},
+		"Ikea":                 {Skip: "ikeapack has neither unions nor optional fields"},
+		"ShamatonMapMsgpack":   {Converter: eventEnvelopeConverter},
+		"ShamatonArrayMsgpack": {Converter: eventEnvelopeConverter},
+	})
+}
@@ -0,0 +1,134 @@
+package goserbench
+
+import (
+	"flag"
+	"os"
+	"runtime"
+	"runtime/metrics"
+	"testing"
+	"time"
+)
+
+// gcstats turns on measuring the garbage collection of the Marshal,
+// MarshalReuse, Unmarshal and RoundTrip loops. It is off by default, as it
+// forces a collection before every loop and samples the heap while it runs.
+var gcstats = flag.Bool("gcstats", os.Getenv("GCSTATS") != "", "report garbage collection cycles, pause and heap high-water mark of every benchmark (default true if $GCSTATS is set)")
+
+// heapObjects is the runtime metric of the bytes in heap objects, live or not
+// yet swept, which is what runtime.MemStats calls HeapAlloc. Unlike
+// ReadMemStats, reading it does not stop the world.
+const heapObjects = "/memory/classes/heap/objects:bytes"
+
+// heapSampling is how often the heap is sampled for its high-water mark. The
+// heap peaks right before each collection, so a peak between two samples
+// is missed only if collections come faster than this.
+const heapSampling = time.Millisecond
+
+// gcStats measures the garbage collection of a benchmark loop: the cycles and
+// pauses from runtime.MemStats before and after, and the heap high-water mark
+// from sampling in between.
+type gcStats struct {
+	before  runtime.MemStats
+	peak    uint64
+	done    chan struct{}
+	stopped chan struct{}
+}
+
+// gcResult is what gcStats measured.
+type gcResult struct {
+	cycles uint32
+	pause  uint64
+	peak   uint64
+}
+
+// startGCStats returns newGCStats, or nil if -gcstats is not set. The report
+// of gcStats does nothing on nil. Call it right before b.StartTimer.
+func startGCStats() *gcStats {
+	if !*gcstats {
+		return nil
+	}
+	return newGCStats()
+}
+
+// newGCStats collects the garbage of the setup, so that the loop pays for no
+// collection but its own, and starts sampling the heap.
+func newGCStats() *gcStats {
+	g := &gcStats{done: make(chan struct{}), stopped: make(chan struct{})}
+	runtime.GC()
+	runtime.ReadMemStats(&g.before)
+	g.peak = g.before.HeapAlloc
+	go g.sample()
+	return g
+}
+
+func (g *gcStats) sample() {
+	defer close(g.stopped)
+	s := []metrics.Sample{{Name: heapObjects}}
+	t := time.NewTicker(heapSampling)
+	defer t.Stop()
+	for {
+		select {
+		case <-g.done:
+			return
+		case <-t.C:
+			metrics.Read(s)
+			if v := s[0].Value.Uint64(); v > g.peak {
+				g.peak = v
+			}
+		}
+	}
+}
+
+// stop stops sampling and returns the collections since startGCStats.
+func (g *gcStats) stop() gcResult {
+	close(g.done)
+	<-g.stopped
+	var after runtime.MemStats
+	runtime.ReadMemStats(&after)
+	peak := g.peak
+	if after.HeapAlloc > peak {
+		peak = after.HeapAlloc
+	}
+	return gcResult{
+		cycles: after.NumGC - g.before.NumGC,
+		pause:  after.PauseTotalNs - g.before.PauseTotalNs,
+		peak:   peak,
+	}
+}
+
+// report stops the timer and reports the collections per million operations,
+// their total stop-the-world pause and that pause per operation, and the heap
+// high-water mark, which includes the corpus and the serialized records the
+// loop works on.
+func (g *gcStats) report(b *testing.B) {
+	b.StopTimer()
+	if g == nil {
+		return
+	}
+	r := g.stop()
+	if b.N == 0 {
+		return
+	}
+	b.ReportMetric(float64(r.cycles)*1e6/float64(b.N), "gc/Mop")
+	b.ReportMetric(float64(r.pause), "gc-pause-ns")
+	b.ReportMetric(float64(r.pause)/float64(b.N), "gc-pause-ns/op")
+	b.ReportMetric(float64(r.peak), "peak-heap-B")
+}
+
+// TestGCStats checks that a collection and a short-lived allocation larger
+// than the heap before it are seen.
+func TestGCStats(t *testing.T) {
+	const size = 64 << 20
+	g := newGCStats()
+	buf := make([]byte, size)
+	time.Sleep(10 * heapSampling)
+	runtime.KeepAlive(buf)
+	runtime.GC()
+	r := g.stop()
+	if r.cycles == 0 {
+		t.Errorf("saw no collection")
+	}
+	if r.peak < size {
+		t.Errorf("heap high-water mark %d, want at least %d", r.peak, size)
+	}
+}
@@ -232,3 +232,2619 @@ func (d *GencodeUnsafeA) Unmarshal(buf []byte) (uint64, error) {
 	}
 	return i + 17, nil
 }
+
+type GencodeUnsafePerson struct {
+	Name     string
+	BirthDay int64
+	Phone    string
+	Money    float64
+	Address  GencodeUnsafeAddress
+	Children []GencodeUnsafeChild
+}
+
+func (d *GencodeUnsafePerson) Size() (s uint64) {
+
+	{
+		l := uint64(len(d.Name))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+		l := uint64(len(d.Phone))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+		s += d.Address.Size()
+	}
+	{
+		l := uint64(len(d.Children))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+
+		for k0 := range d.Children {
+
+			{
+				s += d.Children[k0].Size()
+			}
+
+		}
+
+	}
+	s += 16
+	return
+}
+func (d *GencodeUnsafePerson) Marshal(buf []byte) ([]byte, error) {
+	size := d.Size()
+	{
+		if uint64(cap(buf)) >= size {
+			buf = buf[:size]
+		} else {
+			buf = make([]byte, size)
+		}
+	}
+	i := uint64(0)
+
+	{
+		l := uint64(len(d.Name))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Name)
+		i += l
+	}
+	{
+
+		*(*int64)(unsafe.Pointer(&buf[i+0])) = d.BirthDay
+
+	}
+	{
+		l := uint64(len(d.Phone))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+8] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+8] = byte(t)
+			i++
+
+		}
+		copy(buf[i+8:], d.Phone)
+		i += l
+	}
+	{
+
+		*(*float64)(unsafe.Pointer(&buf[i+8])) = d.Money
+
+	}
+	{
+		nbuf, err := d.Address.Marshal(buf[i+16:])
+		if err != nil {
+			return nil, err
+		}
+		i += uint64(len(nbuf))
+	}
+	{
+		l := uint64(len(d.Children))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+16] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+16] = byte(t)
+			i++
+
+		}
+		for k0 := range d.Children {
+
+			{
+				nbuf, err := d.Children[k0].Marshal(buf[i+16:])
+				if err != nil {
+					return nil, err
+				}
+				i += uint64(len(nbuf))
+			}
+
+		}
+	}
+	return buf[:i+16], nil
+}
+
+func (d *GencodeUnsafePerson) Unmarshal(buf []byte) (uint64, error) {
+	i := uint64(0)
+
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Name = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	{
+
+		d.BirthDay = *(*int64)(unsafe.Pointer(&buf[i+0]))
+
+	}
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+8] & 0x7F)
+			for buf[i+8]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+8]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Phone = string(buf[i+8 : i+8+l])
+		i += l
+	}
+	{
+
+		d.Money = *(*float64)(unsafe.Pointer(&buf[i+8]))
+
+	}
+	{
+		ni, err := d.Address.Unmarshal(buf[i+16:])
+		if err != nil {
+			return 0, err
+		}
+		i += ni
+	}
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+16] & 0x7F)
+			for buf[i+16]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+16]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		if uint64(cap(d.Children)) >= l {
+			d.Children = d.Children[:l]
+		} else {
+			d.Children = make([]GencodeUnsafeChild, l)
+		}
+		for k0 := range d.Children {
+
+			{
+				ni, err := d.Children[k0].Unmarshal(buf[i+16:])
+				if err != nil {
+					return 0, err
+				}
+				i += ni
+			}
+
+		}
+	}
+	return i + 16, nil
+}
+
+type GencodeUnsafeAddress struct {
+	Street  string
+	City    string
+	Zip     string
+	Country string
+}
+
+func (d *GencodeUnsafeAddress) Size() (s uint64) {
+
+	{
+		l := uint64(len(d.Street))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+		l := uint64(len(d.City))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+		l := uint64(len(d.Zip))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+		l := uint64(len(d.Country))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	return
+}
+func (d *GencodeUnsafeAddress) Marshal(buf []byte) ([]byte, error) {
+	size := d.Size()
+	{
+		if uint64(cap(buf)) >= size {
+			buf = buf[:size]
+		} else {
+			buf = make([]byte, size)
+		}
+	}
+	i := uint64(0)
+
+	{
+		l := uint64(len(d.Street))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Street)
+		i += l
+	}
+	{
+		l := uint64(len(d.City))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.City)
+		i += l
+	}
+	{
+		l := uint64(len(d.Zip))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Zip)
+		i += l
+	}
+	{
+		l := uint64(len(d.Country))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Country)
+		i += l
+	}
+	return buf[:i+0], nil
+}
+
+func (d *GencodeUnsafeAddress) Unmarshal(buf []byte) (uint64, error) {
+	i := uint64(0)
+
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Street = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.City = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Zip = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Country = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	return i + 0, nil
+}
+
+type GencodeUnsafeChild struct {
+	Name     string
+	BirthDay int64
+	Grade    int64
+}
+
+func (d *GencodeUnsafeChild) Size() (s uint64) {
+
+	{
+		l := uint64(len(d.Name))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+
+		t := uint64(d.Grade)
+		t <<= 1
+		if d.Grade < 0 {
+			t = ^t
+		}
+		for t >= 0x80 {
+			t >>= 7
+			s++
+		}
+		s++
+
+	}
+	s += 8
+	return
+}
+func (d *GencodeUnsafeChild) Marshal(buf []byte) ([]byte, error) {
+	size := d.Size()
+	{
+		if uint64(cap(buf)) >= size {
+			buf = buf[:size]
+		} else {
+			buf = make([]byte, size)
+		}
+	}
+	i := uint64(0)
+
+	{
+		l := uint64(len(d.Name))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Name)
+		i += l
+	}
+	{
+
+		*(*int64)(unsafe.Pointer(&buf[i+0])) = d.BirthDay
+
+	}
+	{
+
+		t := uint64(d.Grade)
+
+		t <<= 1
+		if d.Grade < 0 {
+			t = ^t
+		}
+
+		for t >= 0x80 {
+			buf[i+8] = byte(t) | 0x80
+			t >>= 7
+			i++
+		}
+		buf[i+8] = byte(t)
+		i++
+
+	}
+	return buf[:i+8], nil
+}
+
+func (d *GencodeUnsafeChild) Unmarshal(buf []byte) (uint64, error) {
+	i := uint64(0)
+
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Name = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	{
+
+		d.BirthDay = *(*int64)(unsafe.Pointer(&buf[i+0]))
+
+	}
+	{
+
+		bs := uint8(7)
+		t := uint64(buf[i+8] & 0x7F)
+		for buf[i+8]&0x80 == 0x80 {
+			i++
+			t |= uint64(buf[i+8]&0x7F) << bs
+			bs += 7
+		}
+		i++
+
+		d.Grade = int64(t >> 1)
+		if t&1 != 0 {
+			d.Grade = ^d.Grade
+		}
+
+	}
+	return i + 8, nil
+}
+
+type GencodeUnsafeTaggedA struct {
+	Name     string
+	BirthDay int64
+	Phone    string
+	Siblings int64
+	Spouse   bool
+	Money    float64
+	Tags     []GencodeUnsafeTag
+	Aliases  []string
+}
+
+func (d *GencodeUnsafeTaggedA) Size() (s uint64) {
+
+	{
+		l := uint64(len(d.Name))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+		l := uint64(len(d.Phone))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+
+		t := uint64(d.Siblings)
+		t <<= 1
+		if d.Siblings < 0 {
+			t = ^t
+		}
+		for t >= 0x80 {
+			t >>= 7
+			s++
+		}
+		s++
+
+	}
+	{
+		l := uint64(len(d.Tags))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+
+		for k0 := range d.Tags {
+
+			{
+				s += d.Tags[k0].Size()
+			}
+
+		}
+
+	}
+	{
+		l := uint64(len(d.Aliases))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+
+		for k0 := range d.Aliases {
+
+			{
+				l := uint64(len(d.Aliases[k0]))
+
+				{
+
+					t := l
+					for t >= 0x80 {
+						t >>= 7
+						s++
+					}
+					s++
+
+				}
+				s += l
+			}
+
+		}
+
+	}
+	s += 17
+	return
+}
+func (d *GencodeUnsafeTaggedA) Marshal(buf []byte) ([]byte, error) {
+	size := d.Size()
+	{
+		if uint64(cap(buf)) >= size {
+			buf = buf[:size]
+		} else {
+			buf = make([]byte, size)
+		}
+	}
+	i := uint64(0)
+
+	{
+		l := uint64(len(d.Name))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Name)
+		i += l
+	}
+	{
+
+		*(*int64)(unsafe.Pointer(&buf[i+0])) = d.BirthDay
+
+	}
+	{
+		l := uint64(len(d.Phone))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+8] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+8] = byte(t)
+			i++
+
+		}
+		copy(buf[i+8:], d.Phone)
+		i += l
+	}
+	{
+
+		t := uint64(d.Siblings)
+
+		t <<= 1
+		if d.Siblings < 0 {
+			t = ^t
+		}
+
+		for t >= 0x80 {
+			buf[i+8] = byte(t) | 0x80
+			t >>= 7
+			i++
+		}
+		buf[i+8] = byte(t)
+		i++
+
+	}
+	{
+		if d.Spouse {
+			buf[i+8] = 1
+		} else {
+			buf[i+8] = 0
+		}
+	}
+	{
+
+		*(*float64)(unsafe.Pointer(&buf[i+9])) = d.Money
+
+	}
+	{
+		l := uint64(len(d.Tags))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+17] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+17] = byte(t)
+			i++
+
+		}
+		for k0 := range d.Tags {
+
+			{
+				nbuf, err := d.Tags[k0].Marshal(buf[i+17:])
+				if err != nil {
+					return nil, err
+				}
+				i += uint64(len(nbuf))
+			}
+
+		}
+	}
+	{
+		l := uint64(len(d.Aliases))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+17] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+17] = byte(t)
+			i++
+
+		}
+		for k0 := range d.Aliases {
+
+			{
+				l := uint64(len(d.Aliases[k0]))
+
+				{
+
+					t := uint64(l)
+
+					for t >= 0x80 {
+						buf[i+17] = byte(t) | 0x80
+						t >>= 7
+						i++
+					}
+					buf[i+17] = byte(t)
+					i++
+
+				}
+				copy(buf[i+17:], d.Aliases[k0])
+				i += l
+			}
+
+		}
+	}
+	return buf[:i+17], nil
+}
+
+func (d *GencodeUnsafeTaggedA) Unmarshal(buf []byte) (uint64, error) {
+	i := uint64(0)
+
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Name = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	{
+
+		d.BirthDay = *(*int64)(unsafe.Pointer(&buf[i+0]))
+
+	}
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+8] & 0x7F)
+			for buf[i+8]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+8]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Phone = string(buf[i+8 : i+8+l])
+		i += l
+	}
+	{
+
+		bs := uint8(7)
+		t := uint64(buf[i+8] & 0x7F)
+		for buf[i+8]&0x80 == 0x80 {
+			i++
+			t |= uint64(buf[i+8]&0x7F) << bs
+			bs += 7
+		}
+		i++
+
+		d.Siblings = int64(t >> 1)
+		if t&1 != 0 {
+			d.Siblings = ^d.Siblings
+		}
+
+	}
+	{
+		d.Spouse = buf[i+8] == 1
+	}
+	{
+
+		d.Money = *(*float64)(unsafe.Pointer(&buf[i+9]))
+
+	}
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+17] & 0x7F)
+			for buf[i+17]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+17]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		if uint64(cap(d.Tags)) >= l {
+			d.Tags = d.Tags[:l]
+		} else {
+			d.Tags = make([]GencodeUnsafeTag, l)
+		}
+		for k0 := range d.Tags {
+
+			{
+				ni, err := d.Tags[k0].Unmarshal(buf[i+17:])
+				if err != nil {
+					return 0, err
+				}
+				i += ni
+			}
+
+		}
+	}
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+17] & 0x7F)
+			for buf[i+17]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+17]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		if uint64(cap(d.Aliases)) >= l {
+			d.Aliases = d.Aliases[:l]
+		} else {
+			d.Aliases = make([]string, l)
+		}
+		for k0 := range d.Aliases {
+
+			{
+				l := uint64(0)
+
+				{
+
+					bs := uint8(7)
+					t := uint64(buf[i+17] & 0x7F)
+					for buf[i+17]&0x80 == 0x80 {
+						i++
+						t |= uint64(buf[i+17]&0x7F) << bs
+						bs += 7
+					}
+					i++
+
+					l = t
+
+				}
+				d.Aliases[k0] = string(buf[i+17 : i+17+l])
+				i += l
+			}
+
+		}
+	}
+	return i + 17, nil
+}
+
+type GencodeUnsafeTag struct {
+	Key   string
+	Value string
+}
+
+func (d *GencodeUnsafeTag) Size() (s uint64) {
+
+	{
+		l := uint64(len(d.Key))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+		l := uint64(len(d.Value))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	return
+}
+func (d *GencodeUnsafeTag) Marshal(buf []byte) ([]byte, error) {
+	size := d.Size()
+	{
+		if uint64(cap(buf)) >= size {
+			buf = buf[:size]
+		} else {
+			buf = make([]byte, size)
+		}
+	}
+	i := uint64(0)
+
+	{
+		l := uint64(len(d.Key))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Key)
+		i += l
+	}
+	{
+		l := uint64(len(d.Value))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Value)
+		i += l
+	}
+	return buf[:i+0], nil
+}
+
+func (d *GencodeUnsafeTag) Unmarshal(buf []byte) (uint64, error) {
+	i := uint64(0)
+
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Key = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Value = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	return i + 0, nil
+}
+
+type GencodeUnsafeBlob struct {
+	Name string
+	Data []byte
+}
+
+func (d *GencodeUnsafeBlob) Size() (s uint64) {
+
+	{
+		l := uint64(len(d.Name))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+		l := uint64(len(d.Data))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	return
+}
+func (d *GencodeUnsafeBlob) Marshal(buf []byte) ([]byte, error) {
+	size := d.Size()
+	{
+		if uint64(cap(buf)) >= size {
+			buf = buf[:size]
+		} else {
+			buf = make([]byte, size)
+		}
+	}
+	i := uint64(0)
+
+	{
+		l := uint64(len(d.Name))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Name)
+		i += l
+	}
+	{
+		l := uint64(len(d.Data))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Data)
+		i += l
+	}
+	return buf[:i+0], nil
+}
+
+func (d *GencodeUnsafeBlob) Unmarshal(buf []byte) (uint64, error) {
+	i := uint64(0)
+
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Name = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		if uint64(cap(d.Data)) >= l {
+			d.Data = d.Data[:l]
+		} else {
+			d.Data = make([]byte, l)
+		}
+		copy(d.Data, buf[i+0:])
+		i += l
+	}
+	return i + 0, nil
+}
+
+type GencodeUnsafeBatch struct {
+	Records []GencodeUnsafeA
+}
+
+func (d *GencodeUnsafeBatch) Size() (s uint64) {
+
+	{
+		l := uint64(len(d.Records))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+
+		for k0 := range d.Records {
+
+			{
+				s += d.Records[k0].Size()
+			}
+
+		}
+
+	}
+	return
+}
+func (d *GencodeUnsafeBatch) Marshal(buf []byte) ([]byte, error) {
+	size := d.Size()
+	{
+		if uint64(cap(buf)) >= size {
+			buf = buf[:size]
+		} else {
+			buf = make([]byte, size)
+		}
+	}
+	i := uint64(0)
+
+	{
+		l := uint64(len(d.Records))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		for k0 := range d.Records {
+
+			{
+				nbuf, err := d.Records[k0].Marshal(buf[i+0:])
+				if err != nil {
+					return nil, err
+				}
+				i += uint64(len(nbuf))
+			}
+
+		}
+	}
+	return buf[:i+0], nil
+}
+
+func (d *GencodeUnsafeBatch) Unmarshal(buf []byte) (uint64, error) {
+	i := uint64(0)
+
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		if uint64(cap(d.Records)) >= l {
+			d.Records = d.Records[:l]
+		} else {
+			d.Records = make([]GencodeUnsafeA, l)
+		}
+		for k0 := range d.Records {
+
+			{
+				ni, err := d.Records[k0].Unmarshal(buf[i+0:])
+				if err != nil {
+					return 0, err
+				}
+				i += ni
+			}
+
+		}
+	}
+	return i + 0, nil
+}
+
+type GencodeUnsafeSeries struct {
+	Name       string
+	Timestamps []int64
+	Values     []float64
+}
+
+func (d *GencodeUnsafeSeries) Size() (s uint64) {
+
+	{
+		l := uint64(len(d.Name))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+		l := uint64(len(d.Timestamps))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += 8 * l
+	}
+	{
+		l := uint64(len(d.Values))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += 8 * l
+	}
+	return
+}
+func (d *GencodeUnsafeSeries) Marshal(buf []byte) ([]byte, error) {
+	size := d.Size()
+	{
+		if uint64(cap(buf)) >= size {
+			buf = buf[:size]
+		} else {
+			buf = make([]byte, size)
+		}
+	}
+	i := uint64(0)
+
+	{
+		l := uint64(len(d.Name))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Name)
+		i += l
+	}
+	{
+		l := uint64(len(d.Timestamps))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		for k0 := range d.Timestamps {
+
+			{
+
+				*(*int64)(unsafe.Pointer(&buf[i+0])) = d.Timestamps[k0]
+
+			}
+
+			i += 8
+
+		}
+	}
+	{
+		l := uint64(len(d.Values))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		for k0 := range d.Values {
+
+			{
+
+				*(*float64)(unsafe.Pointer(&buf[i+0])) = d.Values[k0]
+
+			}
+
+			i += 8
+
+		}
+	}
+	return buf[:i+0], nil
+}
+
+func (d *GencodeUnsafeSeries) Unmarshal(buf []byte) (uint64, error) {
+	i := uint64(0)
+
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Name = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		if uint64(cap(d.Timestamps)) >= l {
+			d.Timestamps = d.Timestamps[:l]
+		} else {
+			d.Timestamps = make([]int64, l)
+		}
+		for k0 := range d.Timestamps {
+
+			{
+
+				d.Timestamps[k0] = *(*int64)(unsafe.Pointer(&buf[i+0]))
+
+			}
+
+			i += 8
+
+		}
+	}
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		if uint64(cap(d.Values)) >= l {
+			d.Values = d.Values[:l]
+		} else {
+			d.Values = make([]float64, l)
+		}
+		for k0 := range d.Values {
+
+			{
+
+				d.Values[k0] = *(*float64)(unsafe.Pointer(&buf[i+0]))
+
+			}
+
+			i += 8
+
+		}
+	}
+	return i + 0, nil
+}
+
+type GencodeUnsafeEvent struct {
+	ID       int64
+	Click    *GencodeUnsafeClick
+	Purchase *GencodeUnsafePurchase
+	Signup   *GencodeUnsafeSignup
+}
+
+func (d *GencodeUnsafeEvent) Size() (s uint64) {
+
+	{
+		if d.Click != nil {
+
+			{
+				s += d.Click.Size()
+			}
+
+		}
+	}
+	{
+		if d.Purchase != nil {
+
+			{
+				s += d.Purchase.Size()
+			}
+
+		}
+	}
+	{
+		if d.Signup != nil {
+
+			{
+				s += d.Signup.Size()
+			}
+
+		}
+	}
+	s += 11
+	return
+}
+func (d *GencodeUnsafeEvent) Marshal(buf []byte) ([]byte, error) {
+	size := d.Size()
+	{
+		if uint64(cap(buf)) >= size {
+			buf = buf[:size]
+		} else {
+			buf = make([]byte, size)
+		}
+	}
+	i := uint64(0)
+
+	{
+
+		*(*int64)(unsafe.Pointer(&buf[i+0])) = d.ID
+
+	}
+	{
+		if d.Click == nil {
+			buf[i+8] = 0
+		} else {
+			buf[i+8] = 1
+
+			{
+				nbuf, err := d.Click.Marshal(buf[i+9:])
+				if err != nil {
+					return nil, err
+				}
+				i += uint64(len(nbuf))
+			}
+		}
+	}
+	{
+		if d.Purchase == nil {
+			buf[i+9] = 0
+		} else {
+			buf[i+9] = 1
+
+			{
+				nbuf, err := d.Purchase.Marshal(buf[i+10:])
+				if err != nil {
+					return nil, err
+				}
+				i += uint64(len(nbuf))
+			}
+		}
+	}
+	{
+		if d.Signup == nil {
+			buf[i+10] = 0
+		} else {
+			buf[i+10] = 1
+
+			{
+				nbuf, err := d.Signup.Marshal(buf[i+11:])
+				if err != nil {
+					return nil, err
+				}
+				i += uint64(len(nbuf))
+			}
+		}
+	}
+	return buf[:i+11], nil
+}
+
+func (d *GencodeUnsafeEvent) Unmarshal(buf []byte) (uint64, error) {
+	i := uint64(0)
+
+	{
+
+		d.ID = *(*int64)(unsafe.Pointer(&buf[i+0]))
+
+	}
+	{
+		if buf[i+8] == 1 {
+			if d.Click == nil {
+				d.Click = new(GencodeUnsafeClick)
+			}
+
+			{
+				ni, err := d.Click.Unmarshal(buf[i+9:])
+				if err != nil {
+					return 0, err
+				}
+				i += ni
+			}
+		} else {
+			d.Click = nil
+		}
+	}
+	{
+		if buf[i+9] == 1 {
+			if d.Purchase == nil {
+				d.Purchase = new(GencodeUnsafePurchase)
+			}
+
+			{
+				ni, err := d.Purchase.Unmarshal(buf[i+10:])
+				if err != nil {
+					return 0, err
+				}
+				i += ni
+			}
+		} else {
+			d.Purchase = nil
+		}
+	}
+	{
+		if buf[i+10] == 1 {
+			if d.Signup == nil {
+				d.Signup = new(GencodeUnsafeSignup)
+			}
+
+			{
+				ni, err := d.Signup.Unmarshal(buf[i+11:])
+				if err != nil {
+					return 0, err
+				}
+				i += ni
+			}
+		} else {
+			d.Signup = nil
+		}
+	}
+	return i + 11, nil
+}
+
+type GencodeUnsafeClick struct {
+	Page string
+	X    int64
+	Y    int64
+}
+
+func (d *GencodeUnsafeClick) Size() (s uint64) {
+
+	{
+		l := uint64(len(d.Page))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+
+		t := uint64(d.X)
+		t <<= 1
+		if d.X < 0 {
+			t = ^t
+		}
+		for t >= 0x80 {
+			t >>= 7
+			s++
+		}
+		s++
+
+	}
+	{
+
+		t := uint64(d.Y)
+		t <<= 1
+		if d.Y < 0 {
+			t = ^t
+		}
+		for t >= 0x80 {
+			t >>= 7
+			s++
+		}
+		s++
+
+	}
+	return
+}
+func (d *GencodeUnsafeClick) Marshal(buf []byte) ([]byte, error) {
+	size := d.Size()
+	{
+		if uint64(cap(buf)) >= size {
+			buf = buf[:size]
+		} else {
+			buf = make([]byte, size)
+		}
+	}
+	i := uint64(0)
+
+	{
+		l := uint64(len(d.Page))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Page)
+		i += l
+	}
+	{
+
+		t := uint64(d.X)
+
+		t <<= 1
+		if d.X < 0 {
+			t = ^t
+		}
+
+		for t >= 0x80 {
+			buf[i+0] = byte(t) | 0x80
+			t >>= 7
+			i++
+		}
+		buf[i+0] = byte(t)
+		i++
+
+	}
+	{
+
+		t := uint64(d.Y)
+
+		t <<= 1
+		if d.Y < 0 {
+			t = ^t
+		}
+
+		for t >= 0x80 {
+			buf[i+0] = byte(t) | 0x80
+			t >>= 7
+			i++
+		}
+		buf[i+0] = byte(t)
+		i++
+
+	}
+	return buf[:i+0], nil
+}
+
+func (d *GencodeUnsafeClick) Unmarshal(buf []byte) (uint64, error) {
+	i := uint64(0)
+
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Page = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	{
+
+		bs := uint8(7)
+		t := uint64(buf[i+0] & 0x7F)
+		for buf[i+0]&0x80 == 0x80 {
+			i++
+			t |= uint64(buf[i+0]&0x7F) << bs
+			bs += 7
+		}
+		i++
+
+		d.X = int64(t >> 1)
+		if t&1 != 0 {
+			d.X = ^d.X
+		}
+
+	}
+	{
+
+		bs := uint8(7)
+		t := uint64(buf[i+0] & 0x7F)
+		for buf[i+0]&0x80 == 0x80 {
+			i++
+			t |= uint64(buf[i+0]&0x7F) << bs
+			bs += 7
+		}
+		i++
+
+		d.Y = int64(t >> 1)
+		if t&1 != 0 {
+			d.Y = ^d.Y
+		}
+
+	}
+	return i + 0, nil
+}
+
+type GencodeUnsafePurchase struct {
+	Item     string
+	Quantity int64
+	Price    float64
+}
+
+func (d *GencodeUnsafePurchase) Size() (s uint64) {
+
+	{
+		l := uint64(len(d.Item))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+
+		t := uint64(d.Quantity)
+		t <<= 1
+		if d.Quantity < 0 {
+			t = ^t
+		}
+		for t >= 0x80 {
+			t >>= 7
+			s++
+		}
+		s++
+
+	}
+	s += 8
+	return
+}
+func (d *GencodeUnsafePurchase) Marshal(buf []byte) ([]byte, error) {
+	size := d.Size()
+	{
+		if uint64(cap(buf)) >= size {
+			buf = buf[:size]
+		} else {
+			buf = make([]byte, size)
+		}
+	}
+	i := uint64(0)
+
+	{
+		l := uint64(len(d.Item))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Item)
+		i += l
+	}
+	{
+
+		t := uint64(d.Quantity)
+
+		t <<= 1
+		if d.Quantity < 0 {
+			t = ^t
+		}
+
+		for t >= 0x80 {
+			buf[i+0] = byte(t) | 0x80
+			t >>= 7
+			i++
+		}
+		buf[i+0] = byte(t)
+		i++
+
+	}
+	{
+
+		*(*float64)(unsafe.Pointer(&buf[i+0])) = d.Price
+
+	}
+	return buf[:i+8], nil
+}
+
+func (d *GencodeUnsafePurchase) Unmarshal(buf []byte) (uint64, error) {
+	i := uint64(0)
+
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Item = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	{
+
+		bs := uint8(7)
+		t := uint64(buf[i+0] & 0x7F)
+		for buf[i+0]&0x80 == 0x80 {
+			i++
+			t |= uint64(buf[i+0]&0x7F) << bs
+			bs += 7
+		}
+		i++
+
+		d.Quantity = int64(t >> 1)
+		if t&1 != 0 {
+			d.Quantity = ^d.Quantity
+		}
+
+	}
+	{
+
+		d.Price = *(*float64)(unsafe.Pointer(&buf[i+0]))
+
+	}
+	return i + 8, nil
+}
+
+type GencodeUnsafeSignup struct {
+	Name     string
+	Email    string
+	Referrer string
+}
+
+func (d *GencodeUnsafeSignup) Size() (s uint64) {
+
+	{
+		l := uint64(len(d.Name))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+		l := uint64(len(d.Email))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+		l := uint64(len(d.Referrer))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	return
+}
+func (d *GencodeUnsafeSignup) Marshal(buf []byte) ([]byte, error) {
+	size := d.Size()
+	{
+		if uint64(cap(buf)) >= size {
+			buf = buf[:size]
+		} else {
+			buf = make([]byte, size)
+		}
+	}
+	i := uint64(0)
+
+	{
+		l := uint64(len(d.Name))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Name)
+		i += l
+	}
+	{
+		l := uint64(len(d.Email))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Email)
+		i += l
+	}
+	{
+		l := uint64(len(d.Referrer))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Referrer)
+		i += l
+	}
+	return buf[:i+0], nil
+}
+
+func (d *GencodeUnsafeSignup) Unmarshal(buf []byte) (uint64, error) {
+	i := uint64(0)
+
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Name = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Email = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Referrer = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	return i + 0, nil
+}
+
+type GencodeUnsafeNode struct {
+	Name     string
+	Value    int64
+	Children []GencodeUnsafeNode
+}
+
+func (d *GencodeUnsafeNode) Size() (s uint64) {
+
+	{
+		l := uint64(len(d.Name))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+
+		t := uint64(d.Value)
+		t <<= 1
+		if d.Value < 0 {
+			t = ^t
+		}
+		for t >= 0x80 {
+			t >>= 7
+			s++
+		}
+		s++
+
+	}
+	{
+		l := uint64(len(d.Children))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+
+		for k0 := range d.Children {
+
+			{
+				s += d.Children[k0].Size()
+			}
+
+		}
+
+	}
+	return
+}
+func (d *GencodeUnsafeNode) Marshal(buf []byte) ([]byte, error) {
+	size := d.Size()
+	{
+		if uint64(cap(buf)) >= size {
+			buf = buf[:size]
+		} else {
+			buf = make([]byte, size)
+		}
+	}
+	i := uint64(0)
+
+	{
+		l := uint64(len(d.Name))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Name)
+		i += l
+	}
+	{
+
+		t := uint64(d.Value)
+
+		t <<= 1
+		if d.Value < 0 {
+			t = ^t
+		}
+
+		for t >= 0x80 {
+			buf[i+0] = byte(t) | 0x80
+			t >>= 7
+			i++
+		}
+		buf[i+0] = byte(t)
+		i++
+
+	}
+	{
+		l := uint64(len(d.Children))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		for k0 := range d.Children {
+
+			{
+				nbuf, err := d.Children[k0].Marshal(buf[i+0:])
+				if err != nil {
+					return nil, err
+				}
+				i += uint64(len(nbuf))
+			}
+
+		}
+	}
+	return buf[:i+0], nil
+}
+
+func (d *GencodeUnsafeNode) Unmarshal(buf []byte) (uint64, error) {
+	i := uint64(0)
+
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Name = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	{
+
+		bs := uint8(7)
+		t := uint64(buf[i+0] & 0x7F)
+		for buf[i+0]&0x80 == 0x80 {
+			i++
+			t |= uint64(buf[i+0]&0x7F) << bs
+			bs += 7
+		}
+		i++
+
+		d.Value = int64(t >> 1)
+		if t&1 != 0 {
+			d.Value = ^d.Value
+		}
+
+	}
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		if uint64(cap(d.Children)) >= l {
+			d.Children = d.Children[:l]
+		} else {
+			d.Children = make([]GencodeUnsafeNode, l)
+		}
+		for k0 := range d.Children {
+
+			{
+				ni, err := d.Children[k0].Unmarshal(buf[i+0:])
+				if err != nil {
+					return 0, err
+				}
+				i += ni
+			}
+
+		}
+	}
+	return i + 0, nil
+}
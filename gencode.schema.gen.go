@@ -249,3 +249,2715 @@ func (d *GencodeA) Unmarshal(buf []byte) (uint64, error) {
 	}
 	return i + 24, nil
 }
+
+type GencodePerson struct {
+	Name     string
+	BirthDay time.Time
+	Phone    string
+	Money    float64
+	Address  GencodeAddress
+	Children []GencodeChild
+}
+
+func (d *GencodePerson) Size() (s uint64) {
+
+	{
+		l := uint64(len(d.Name))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+		l := uint64(len(d.Phone))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+		s += d.Address.Size()
+	}
+	{
+		l := uint64(len(d.Children))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+
+		for k0 := range d.Children {
+
+			{
+				s += d.Children[k0].Size()
+			}
+
+		}
+
+	}
+	s += 23
+	return
+}
+func (d *GencodePerson) Marshal(buf []byte) ([]byte, error) {
+	size := d.Size()
+	{
+		if uint64(cap(buf)) >= size {
+			buf = buf[:size]
+		} else {
+			buf = make([]byte, size)
+		}
+	}
+	i := uint64(0)
+
+	{
+		l := uint64(len(d.Name))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Name)
+		i += l
+	}
+	{
+		b, err := d.BirthDay.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		copy(buf[i+0:], b)
+	}
+	{
+		l := uint64(len(d.Phone))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+15] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+15] = byte(t)
+			i++
+
+		}
+		copy(buf[i+15:], d.Phone)
+		i += l
+	}
+	{
+
+		v := *(*uint64)(unsafe.Pointer(&(d.Money)))
+
+		buf[i+0+15] = byte(v >> 0)
+
+		buf[i+1+15] = byte(v >> 8)
+
+		buf[i+2+15] = byte(v >> 16)
+
+		buf[i+3+15] = byte(v >> 24)
+
+		buf[i+4+15] = byte(v >> 32)
+
+		buf[i+5+15] = byte(v >> 40)
+
+		buf[i+6+15] = byte(v >> 48)
+
+		buf[i+7+15] = byte(v >> 56)
+
+	}
+	{
+		nbuf, err := d.Address.Marshal(buf[i+23:])
+		if err != nil {
+			return nil, err
+		}
+		i += uint64(len(nbuf))
+	}
+	{
+		l := uint64(len(d.Children))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+23] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+23] = byte(t)
+			i++
+
+		}
+		for k0 := range d.Children {
+
+			{
+				nbuf, err := d.Children[k0].Marshal(buf[i+23:])
+				if err != nil {
+					return nil, err
+				}
+				i += uint64(len(nbuf))
+			}
+
+		}
+	}
+	return buf[:i+23], nil
+}
+
+func (d *GencodePerson) Unmarshal(buf []byte) (uint64, error) {
+	i := uint64(0)
+
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Name = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	{
+		d.BirthDay.UnmarshalBinary(buf[i+0 : i+0+15])
+	}
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+15] & 0x7F)
+			for buf[i+15]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+15]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Phone = string(buf[i+15 : i+15+l])
+		i += l
+	}
+	{
+
+		v := 0 | (uint64(buf[i+0+15]) << 0) | (uint64(buf[i+1+15]) << 8) | (uint64(buf[i+2+15]) << 16) | (uint64(buf[i+3+15]) << 24) | (uint64(buf[i+4+15]) << 32) | (uint64(buf[i+5+15]) << 40) | (uint64(buf[i+6+15]) << 48) | (uint64(buf[i+7+15]) << 56)
+		d.Money = *(*float64)(unsafe.Pointer(&v))
+
+	}
+	{
+		ni, err := d.Address.Unmarshal(buf[i+23:])
+		if err != nil {
+			return 0, err
+		}
+		i += ni
+	}
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+23] & 0x7F)
+			for buf[i+23]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+23]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		if uint64(cap(d.Children)) >= l {
+			d.Children = d.Children[:l]
+		} else {
+			d.Children = make([]GencodeChild, l)
+		}
+		for k0 := range d.Children {
+
+			{
+				ni, err := d.Children[k0].Unmarshal(buf[i+23:])
+				if err != nil {
+					return 0, err
+				}
+				i += ni
+			}
+
+		}
+	}
+	return i + 23, nil
+}
+
+type GencodeAddress struct {
+	Street  string
+	City    string
+	Zip     string
+	Country string
+}
+
+func (d *GencodeAddress) Size() (s uint64) {
+
+	{
+		l := uint64(len(d.Street))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+		l := uint64(len(d.City))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+		l := uint64(len(d.Zip))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+		l := uint64(len(d.Country))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	return
+}
+func (d *GencodeAddress) Marshal(buf []byte) ([]byte, error) {
+	size := d.Size()
+	{
+		if uint64(cap(buf)) >= size {
+			buf = buf[:size]
+		} else {
+			buf = make([]byte, size)
+		}
+	}
+	i := uint64(0)
+
+	{
+		l := uint64(len(d.Street))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Street)
+		i += l
+	}
+	{
+		l := uint64(len(d.City))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.City)
+		i += l
+	}
+	{
+		l := uint64(len(d.Zip))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Zip)
+		i += l
+	}
+	{
+		l := uint64(len(d.Country))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Country)
+		i += l
+	}
+	return buf[:i+0], nil
+}
+
+func (d *GencodeAddress) Unmarshal(buf []byte) (uint64, error) {
+	i := uint64(0)
+
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Street = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.City = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Zip = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Country = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	return i + 0, nil
+}
+
+type GencodeChild struct {
+	Name     string
+	BirthDay time.Time
+	Grade    int64
+}
+
+func (d *GencodeChild) Size() (s uint64) {
+
+	{
+		l := uint64(len(d.Name))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+
+		t := uint64(d.Grade)
+		t <<= 1
+		if d.Grade < 0 {
+			t = ^t
+		}
+		for t >= 0x80 {
+			t >>= 7
+			s++
+		}
+		s++
+
+	}
+	s += 15
+	return
+}
+func (d *GencodeChild) Marshal(buf []byte) ([]byte, error) {
+	size := d.Size()
+	{
+		if uint64(cap(buf)) >= size {
+			buf = buf[:size]
+		} else {
+			buf = make([]byte, size)
+		}
+	}
+	i := uint64(0)
+
+	{
+		l := uint64(len(d.Name))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Name)
+		i += l
+	}
+	{
+		b, err := d.BirthDay.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		copy(buf[i+0:], b)
+	}
+	{
+
+		t := uint64(d.Grade)
+
+		t <<= 1
+		if d.Grade < 0 {
+			t = ^t
+		}
+
+		for t >= 0x80 {
+			buf[i+15] = byte(t) | 0x80
+			t >>= 7
+			i++
+		}
+		buf[i+15] = byte(t)
+		i++
+
+	}
+	return buf[:i+15], nil
+}
+
+func (d *GencodeChild) Unmarshal(buf []byte) (uint64, error) {
+	i := uint64(0)
+
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Name = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	{
+		d.BirthDay.UnmarshalBinary(buf[i+0 : i+0+15])
+	}
+	{
+
+		bs := uint8(7)
+		t := uint64(buf[i+15] & 0x7F)
+		for buf[i+15]&0x80 == 0x80 {
+			i++
+			t |= uint64(buf[i+15]&0x7F) << bs
+			bs += 7
+		}
+		i++
+
+		d.Grade = int64(t >> 1)
+		if t&1 != 0 {
+			d.Grade = ^d.Grade
+		}
+
+	}
+	return i + 15, nil
+}
+
+type GencodeTaggedA struct {
+	Name     string
+	BirthDay time.Time
+	Phone    string
+	Siblings int64
+	Spouse   bool
+	Money    float64
+	Tags     []GencodeTag
+	Aliases  []string
+}
+
+func (d *GencodeTaggedA) Size() (s uint64) {
+
+	{
+		l := uint64(len(d.Name))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+		l := uint64(len(d.Phone))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+
+		t := uint64(d.Siblings)
+		t <<= 1
+		if d.Siblings < 0 {
+			t = ^t
+		}
+		for t >= 0x80 {
+			t >>= 7
+			s++
+		}
+		s++
+
+	}
+	{
+		l := uint64(len(d.Tags))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+
+		for k0 := range d.Tags {
+
+			{
+				s += d.Tags[k0].Size()
+			}
+
+		}
+
+	}
+	{
+		l := uint64(len(d.Aliases))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+
+		for k0 := range d.Aliases {
+
+			{
+				l := uint64(len(d.Aliases[k0]))
+
+				{
+
+					t := l
+					for t >= 0x80 {
+						t >>= 7
+						s++
+					}
+					s++
+
+				}
+				s += l
+			}
+
+		}
+
+	}
+	s += 24
+	return
+}
+func (d *GencodeTaggedA) Marshal(buf []byte) ([]byte, error) {
+	size := d.Size()
+	{
+		if uint64(cap(buf)) >= size {
+			buf = buf[:size]
+		} else {
+			buf = make([]byte, size)
+		}
+	}
+	i := uint64(0)
+
+	{
+		l := uint64(len(d.Name))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Name)
+		i += l
+	}
+	{
+		b, err := d.BirthDay.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		copy(buf[i+0:], b)
+	}
+	{
+		l := uint64(len(d.Phone))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+15] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+15] = byte(t)
+			i++
+
+		}
+		copy(buf[i+15:], d.Phone)
+		i += l
+	}
+	{
+
+		t := uint64(d.Siblings)
+
+		t <<= 1
+		if d.Siblings < 0 {
+			t = ^t
+		}
+
+		for t >= 0x80 {
+			buf[i+15] = byte(t) | 0x80
+			t >>= 7
+			i++
+		}
+		buf[i+15] = byte(t)
+		i++
+
+	}
+	{
+		if d.Spouse {
+			buf[i+15] = 1
+		} else {
+			buf[i+15] = 0
+		}
+	}
+	{
+
+		v := *(*uint64)(unsafe.Pointer(&(d.Money)))
+
+		buf[i+0+16] = byte(v >> 0)
+
+		buf[i+1+16] = byte(v >> 8)
+
+		buf[i+2+16] = byte(v >> 16)
+
+		buf[i+3+16] = byte(v >> 24)
+
+		buf[i+4+16] = byte(v >> 32)
+
+		buf[i+5+16] = byte(v >> 40)
+
+		buf[i+6+16] = byte(v >> 48)
+
+		buf[i+7+16] = byte(v >> 56)
+
+	}
+	{
+		l := uint64(len(d.Tags))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+24] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+24] = byte(t)
+			i++
+
+		}
+		for k0 := range d.Tags {
+
+			{
+				nbuf, err := d.Tags[k0].Marshal(buf[i+24:])
+				if err != nil {
+					return nil, err
+				}
+				i += uint64(len(nbuf))
+			}
+
+		}
+	}
+	{
+		l := uint64(len(d.Aliases))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+24] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+24] = byte(t)
+			i++
+
+		}
+		for k0 := range d.Aliases {
+
+			{
+				l := uint64(len(d.Aliases[k0]))
+
+				{
+
+					t := uint64(l)
+
+					for t >= 0x80 {
+						buf[i+24] = byte(t) | 0x80
+						t >>= 7
+						i++
+					}
+					buf[i+24] = byte(t)
+					i++
+
+				}
+				copy(buf[i+24:], d.Aliases[k0])
+				i += l
+			}
+
+		}
+	}
+	return buf[:i+24], nil
+}
+
+func (d *GencodeTaggedA) Unmarshal(buf []byte) (uint64, error) {
+	i := uint64(0)
+
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Name = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	{
+		d.BirthDay.UnmarshalBinary(buf[i+0 : i+0+15])
+	}
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+15] & 0x7F)
+			for buf[i+15]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+15]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Phone = string(buf[i+15 : i+15+l])
+		i += l
+	}
+	{
+
+		bs := uint8(7)
+		t := uint64(buf[i+15] & 0x7F)
+		for buf[i+15]&0x80 == 0x80 {
+			i++
+			t |= uint64(buf[i+15]&0x7F) << bs
+			bs += 7
+		}
+		i++
+
+		d.Siblings = int64(t >> 1)
+		if t&1 != 0 {
+			d.Siblings = ^d.Siblings
+		}
+
+	}
+	{
+		d.Spouse = buf[i+15] == 1
+	}
+	{
+
+		v := 0 | (uint64(buf[i+0+16]) << 0) | (uint64(buf[i+1+16]) << 8) | (uint64(buf[i+2+16]) << 16) | (uint64(buf[i+3+16]) << 24) | (uint64(buf[i+4+16]) << 32) | (uint64(buf[i+5+16]) << 40) | (uint64(buf[i+6+16]) << 48) | (uint64(buf[i+7+16]) << 56)
+		d.Money = *(*float64)(unsafe.Pointer(&v))
+
+	}
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+24] & 0x7F)
+			for buf[i+24]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+24]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		if uint64(cap(d.Tags)) >= l {
+			d.Tags = d.Tags[:l]
+		} else {
+			d.Tags = make([]GencodeTag, l)
+		}
+		for k0 := range d.Tags {
+
+			{
+				ni, err := d.Tags[k0].Unmarshal(buf[i+24:])
+				if err != nil {
+					return 0, err
+				}
+				i += ni
+			}
+
+		}
+	}
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+24] & 0x7F)
+			for buf[i+24]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+24]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		if uint64(cap(d.Aliases)) >= l {
+			d.Aliases = d.Aliases[:l]
+		} else {
+			d.Aliases = make([]string, l)
+		}
+		for k0 := range d.Aliases {
+
+			{
+				l := uint64(0)
+
+				{
+
+					bs := uint8(7)
+					t := uint64(buf[i+24] & 0x7F)
+					for buf[i+24]&0x80 == 0x80 {
+						i++
+						t |= uint64(buf[i+24]&0x7F) << bs
+						bs += 7
+					}
+					i++
+
+					l = t
+
+				}
+				d.Aliases[k0] = string(buf[i+24 : i+24+l])
+				i += l
+			}
+
+		}
+	}
+	return i + 24, nil
+}
+
+type GencodeTag struct {
+	Key   string
+	Value string
+}
+
+func (d *GencodeTag) Size() (s uint64) {
+
+	{
+		l := uint64(len(d.Key))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+		l := uint64(len(d.Value))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	return
+}
+func (d *GencodeTag) Marshal(buf []byte) ([]byte, error) {
+	size := d.Size()
+	{
+		if uint64(cap(buf)) >= size {
+			buf = buf[:size]
+		} else {
+			buf = make([]byte, size)
+		}
+	}
+	i := uint64(0)
+
+	{
+		l := uint64(len(d.Key))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Key)
+		i += l
+	}
+	{
+		l := uint64(len(d.Value))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Value)
+		i += l
+	}
+	return buf[:i+0], nil
+}
+
+func (d *GencodeTag) Unmarshal(buf []byte) (uint64, error) {
+	i := uint64(0)
+
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Key = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Value = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	return i + 0, nil
+}
+
+type GencodeBlob struct {
+	Name string
+	Data []byte
+}
+
+func (d *GencodeBlob) Size() (s uint64) {
+
+	{
+		l := uint64(len(d.Name))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+		l := uint64(len(d.Data))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	return
+}
+func (d *GencodeBlob) Marshal(buf []byte) ([]byte, error) {
+	size := d.Size()
+	{
+		if uint64(cap(buf)) >= size {
+			buf = buf[:size]
+		} else {
+			buf = make([]byte, size)
+		}
+	}
+	i := uint64(0)
+
+	{
+		l := uint64(len(d.Name))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Name)
+		i += l
+	}
+	{
+		l := uint64(len(d.Data))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Data)
+		i += l
+	}
+	return buf[:i+0], nil
+}
+
+func (d *GencodeBlob) Unmarshal(buf []byte) (uint64, error) {
+	i := uint64(0)
+
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Name = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		if uint64(cap(d.Data)) >= l {
+			d.Data = d.Data[:l]
+		} else {
+			d.Data = make([]byte, l)
+		}
+		copy(d.Data, buf[i+0:])
+		i += l
+	}
+	return i + 0, nil
+}
+
+type GencodeBatch struct {
+	Records []GencodeA
+}
+
+func (d *GencodeBatch) Size() (s uint64) {
+
+	{
+		l := uint64(len(d.Records))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+
+		for k0 := range d.Records {
+
+			{
+				s += d.Records[k0].Size()
+			}
+
+		}
+
+	}
+	return
+}
+func (d *GencodeBatch) Marshal(buf []byte) ([]byte, error) {
+	size := d.Size()
+	{
+		if uint64(cap(buf)) >= size {
+			buf = buf[:size]
+		} else {
+			buf = make([]byte, size)
+		}
+	}
+	i := uint64(0)
+
+	{
+		l := uint64(len(d.Records))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		for k0 := range d.Records {
+
+			{
+				nbuf, err := d.Records[k0].Marshal(buf[i+0:])
+				if err != nil {
+					return nil, err
+				}
+				i += uint64(len(nbuf))
+			}
+
+		}
+	}
+	return buf[:i+0], nil
+}
+
+func (d *GencodeBatch) Unmarshal(buf []byte) (uint64, error) {
+	i := uint64(0)
+
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		if uint64(cap(d.Records)) >= l {
+			d.Records = d.Records[:l]
+		} else {
+			d.Records = make([]GencodeA, l)
+		}
+		for k0 := range d.Records {
+
+			{
+				ni, err := d.Records[k0].Unmarshal(buf[i+0:])
+				if err != nil {
+					return 0, err
+				}
+				i += ni
+			}
+
+		}
+	}
+	return i + 0, nil
+}
+
+type GencodeSeries struct {
+	Name       string
+	Timestamps []int64
+	Values     []float64
+}
+
+func (d *GencodeSeries) Size() (s uint64) {
+
+	{
+		l := uint64(len(d.Name))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+		l := uint64(len(d.Timestamps))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += 8 * l
+	}
+	{
+		l := uint64(len(d.Values))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += 8 * l
+	}
+	return
+}
+func (d *GencodeSeries) Marshal(buf []byte) ([]byte, error) {
+	size := d.Size()
+	{
+		if uint64(cap(buf)) >= size {
+			buf = buf[:size]
+		} else {
+			buf = make([]byte, size)
+		}
+	}
+	i := uint64(0)
+
+	{
+		l := uint64(len(d.Name))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Name)
+		i += l
+	}
+	{
+		l := uint64(len(d.Timestamps))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		for k0 := range d.Timestamps {
+
+			{
+
+				buf[i+0+0] = byte(d.Timestamps[k0] >> 0)
+
+				buf[i+1+0] = byte(d.Timestamps[k0] >> 8)
+
+				buf[i+2+0] = byte(d.Timestamps[k0] >> 16)
+
+				buf[i+3+0] = byte(d.Timestamps[k0] >> 24)
+
+				buf[i+4+0] = byte(d.Timestamps[k0] >> 32)
+
+				buf[i+5+0] = byte(d.Timestamps[k0] >> 40)
+
+				buf[i+6+0] = byte(d.Timestamps[k0] >> 48)
+
+				buf[i+7+0] = byte(d.Timestamps[k0] >> 56)
+
+			}
+
+			i += 8
+
+		}
+	}
+	{
+		l := uint64(len(d.Values))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		for k0 := range d.Values {
+
+			{
+
+				v := *(*uint64)(unsafe.Pointer(&(d.Values[k0])))
+
+				buf[i+0+0] = byte(v >> 0)
+
+				buf[i+1+0] = byte(v >> 8)
+
+				buf[i+2+0] = byte(v >> 16)
+
+				buf[i+3+0] = byte(v >> 24)
+
+				buf[i+4+0] = byte(v >> 32)
+
+				buf[i+5+0] = byte(v >> 40)
+
+				buf[i+6+0] = byte(v >> 48)
+
+				buf[i+7+0] = byte(v >> 56)
+
+			}
+
+			i += 8
+
+		}
+	}
+	return buf[:i+0], nil
+}
+
+func (d *GencodeSeries) Unmarshal(buf []byte) (uint64, error) {
+	i := uint64(0)
+
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Name = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		if uint64(cap(d.Timestamps)) >= l {
+			d.Timestamps = d.Timestamps[:l]
+		} else {
+			d.Timestamps = make([]int64, l)
+		}
+		for k0 := range d.Timestamps {
+
+			{
+
+				d.Timestamps[k0] = 0 | (int64(buf[i+0+0]) << 0) | (int64(buf[i+1+0]) << 8) | (int64(buf[i+2+0]) << 16) | (int64(buf[i+3+0]) << 24) | (int64(buf[i+4+0]) << 32) | (int64(buf[i+5+0]) << 40) | (int64(buf[i+6+0]) << 48) | (int64(buf[i+7+0]) << 56)
+
+			}
+
+			i += 8
+
+		}
+	}
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		if uint64(cap(d.Values)) >= l {
+			d.Values = d.Values[:l]
+		} else {
+			d.Values = make([]float64, l)
+		}
+		for k0 := range d.Values {
+
+			{
+
+				v := 0 | (uint64(buf[i+0+0]) << 0) | (uint64(buf[i+1+0]) << 8) | (uint64(buf[i+2+0]) << 16) | (uint64(buf[i+3+0]) << 24) | (uint64(buf[i+4+0]) << 32) | (uint64(buf[i+5+0]) << 40) | (uint64(buf[i+6+0]) << 48) | (uint64(buf[i+7+0]) << 56)
+				d.Values[k0] = *(*float64)(unsafe.Pointer(&v))
+
+			}
+
+			i += 8
+
+		}
+	}
+	return i + 0, nil
+}
+
+type GencodeEvent struct {
+	ID       int64
+	Click    *GencodeClick
+	Purchase *GencodePurchase
+	Signup   *GencodeSignup
+}
+
+func (d *GencodeEvent) Size() (s uint64) {
+
+	{
+		if d.Click != nil {
+
+			{
+				s += d.Click.Size()
+			}
+
+		}
+	}
+	{
+		if d.Purchase != nil {
+
+			{
+				s += d.Purchase.Size()
+			}
+
+		}
+	}
+	{
+		if d.Signup != nil {
+
+			{
+				s += d.Signup.Size()
+			}
+
+		}
+	}
+	s += 11
+	return
+}
+func (d *GencodeEvent) Marshal(buf []byte) ([]byte, error) {
+	size := d.Size()
+	{
+		if uint64(cap(buf)) >= size {
+			buf = buf[:size]
+		} else {
+			buf = make([]byte, size)
+		}
+	}
+	i := uint64(0)
+
+	{
+
+		buf[i+0+0] = byte(d.ID >> 0)
+
+		buf[i+1+0] = byte(d.ID >> 8)
+
+		buf[i+2+0] = byte(d.ID >> 16)
+
+		buf[i+3+0] = byte(d.ID >> 24)
+
+		buf[i+4+0] = byte(d.ID >> 32)
+
+		buf[i+5+0] = byte(d.ID >> 40)
+
+		buf[i+6+0] = byte(d.ID >> 48)
+
+		buf[i+7+0] = byte(d.ID >> 56)
+
+	}
+	{
+		if d.Click == nil {
+			buf[i+8] = 0
+		} else {
+			buf[i+8] = 1
+
+			{
+				nbuf, err := d.Click.Marshal(buf[i+9:])
+				if err != nil {
+					return nil, err
+				}
+				i += uint64(len(nbuf))
+			}
+		}
+	}
+	{
+		if d.Purchase == nil {
+			buf[i+9] = 0
+		} else {
+			buf[i+9] = 1
+
+			{
+				nbuf, err := d.Purchase.Marshal(buf[i+10:])
+				if err != nil {
+					return nil, err
+				}
+				i += uint64(len(nbuf))
+			}
+		}
+	}
+	{
+		if d.Signup == nil {
+			buf[i+10] = 0
+		} else {
+			buf[i+10] = 1
+
+			{
+				nbuf, err := d.Signup.Marshal(buf[i+11:])
+				if err != nil {
+					return nil, err
+				}
+				i += uint64(len(nbuf))
+			}
+		}
+	}
+	return buf[:i+11], nil
+}
+
+func (d *GencodeEvent) Unmarshal(buf []byte) (uint64, error) {
+	i := uint64(0)
+
+	{
+
+		d.ID = 0 | (int64(buf[i+0+0]) << 0) | (int64(buf[i+1+0]) << 8) | (int64(buf[i+2+0]) << 16) | (int64(buf[i+3+0]) << 24) | (int64(buf[i+4+0]) << 32) | (int64(buf[i+5+0]) << 40) | (int64(buf[i+6+0]) << 48) | (int64(buf[i+7+0]) << 56)
+
+	}
+	{
+		if buf[i+8] == 1 {
+			if d.Click == nil {
+				d.Click = new(GencodeClick)
+			}
+
+			{
+				ni, err := d.Click.Unmarshal(buf[i+9:])
+				if err != nil {
+					return 0, err
+				}
+				i += ni
+			}
+		} else {
+			d.Click = nil
+		}
+	}
+	{
+		if buf[i+9] == 1 {
+			if d.Purchase == nil {
+				d.Purchase = new(GencodePurchase)
+			}
+
+			{
+				ni, err := d.Purchase.Unmarshal(buf[i+10:])
+				if err != nil {
+					return 0, err
+				}
+				i += ni
+			}
+		} else {
+			d.Purchase = nil
+		}
+	}
+	{
+		if buf[i+10] == 1 {
+			if d.Signup == nil {
+				d.Signup = new(GencodeSignup)
+			}
+
+			{
+				ni, err := d.Signup.Unmarshal(buf[i+11:])
+				if err != nil {
+					return 0, err
+				}
+				i += ni
+			}
+		} else {
+			d.Signup = nil
+		}
+	}
+	return i + 11, nil
+}
+
+type GencodeClick struct {
+	Page string
+	X    int64
+	Y    int64
+}
+
+func (d *GencodeClick) Size() (s uint64) {
+
+	{
+		l := uint64(len(d.Page))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+
+		t := uint64(d.X)
+		t <<= 1
+		if d.X < 0 {
+			t = ^t
+		}
+		for t >= 0x80 {
+			t >>= 7
+			s++
+		}
+		s++
+
+	}
+	{
+
+		t := uint64(d.Y)
+		t <<= 1
+		if d.Y < 0 {
+			t = ^t
+		}
+		for t >= 0x80 {
+			t >>= 7
+			s++
+		}
+		s++
+
+	}
+	return
+}
+func (d *GencodeClick) Marshal(buf []byte) ([]byte, error) {
+	size := d.Size()
+	{
+		if uint64(cap(buf)) >= size {
+			buf = buf[:size]
+		} else {
+			buf = make([]byte, size)
+		}
+	}
+	i := uint64(0)
+
+	{
+		l := uint64(len(d.Page))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Page)
+		i += l
+	}
+	{
+
+		t := uint64(d.X)
+
+		t <<= 1
+		if d.X < 0 {
+			t = ^t
+		}
+
+		for t >= 0x80 {
+			buf[i+0] = byte(t) | 0x80
+			t >>= 7
+			i++
+		}
+		buf[i+0] = byte(t)
+		i++
+
+	}
+	{
+
+		t := uint64(d.Y)
+
+		t <<= 1
+		if d.Y < 0 {
+			t = ^t
+		}
+
+		for t >= 0x80 {
+			buf[i+0] = byte(t) | 0x80
+			t >>= 7
+			i++
+		}
+		buf[i+0] = byte(t)
+		i++
+
+	}
+	return buf[:i+0], nil
+}
+
+func (d *GencodeClick) Unmarshal(buf []byte) (uint64, error) {
+	i := uint64(0)
+
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Page = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	{
+
+		bs := uint8(7)
+		t := uint64(buf[i+0] & 0x7F)
+		for buf[i+0]&0x80 == 0x80 {
+			i++
+			t |= uint64(buf[i+0]&0x7F) << bs
+			bs += 7
+		}
+		i++
+
+		d.X = int64(t >> 1)
+		if t&1 != 0 {
+			d.X = ^d.X
+		}
+
+	}
+	{
+
+		bs := uint8(7)
+		t := uint64(buf[i+0] & 0x7F)
+		for buf[i+0]&0x80 == 0x80 {
+			i++
+			t |= uint64(buf[i+0]&0x7F) << bs
+			bs += 7
+		}
+		i++
+
+		d.Y = int64(t >> 1)
+		if t&1 != 0 {
+			d.Y = ^d.Y
+		}
+
+	}
+	return i + 0, nil
+}
+
+type GencodePurchase struct {
+	Item     string
+	Quantity int64
+	Price    float64
+}
+
+func (d *GencodePurchase) Size() (s uint64) {
+
+	{
+		l := uint64(len(d.Item))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+
+		t := uint64(d.Quantity)
+		t <<= 1
+		if d.Quantity < 0 {
+			t = ^t
+		}
+		for t >= 0x80 {
+			t >>= 7
+			s++
+		}
+		s++
+
+	}
+	s += 8
+	return
+}
+func (d *GencodePurchase) Marshal(buf []byte) ([]byte, error) {
+	size := d.Size()
+	{
+		if uint64(cap(buf)) >= size {
+			buf = buf[:size]
+		} else {
+			buf = make([]byte, size)
+		}
+	}
+	i := uint64(0)
+
+	{
+		l := uint64(len(d.Item))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Item)
+		i += l
+	}
+	{
+
+		t := uint64(d.Quantity)
+
+		t <<= 1
+		if d.Quantity < 0 {
+			t = ^t
+		}
+
+		for t >= 0x80 {
+			buf[i+0] = byte(t) | 0x80
+			t >>= 7
+			i++
+		}
+		buf[i+0] = byte(t)
+		i++
+
+	}
+	{
+
+		v := *(*uint64)(unsafe.Pointer(&(d.Price)))
+
+		buf[i+0+0] = byte(v >> 0)
+
+		buf[i+1+0] = byte(v >> 8)
+
+		buf[i+2+0] = byte(v >> 16)
+
+		buf[i+3+0] = byte(v >> 24)
+
+		buf[i+4+0] = byte(v >> 32)
+
+		buf[i+5+0] = byte(v >> 40)
+
+		buf[i+6+0] = byte(v >> 48)
+
+		buf[i+7+0] = byte(v >> 56)
+
+	}
+	return buf[:i+8], nil
+}
+
+func (d *GencodePurchase) Unmarshal(buf []byte) (uint64, error) {
+	i := uint64(0)
+
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Item = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	{
+
+		bs := uint8(7)
+		t := uint64(buf[i+0] & 0x7F)
+		for buf[i+0]&0x80 == 0x80 {
+			i++
+			t |= uint64(buf[i+0]&0x7F) << bs
+			bs += 7
+		}
+		i++
+
+		d.Quantity = int64(t >> 1)
+		if t&1 != 0 {
+			d.Quantity = ^d.Quantity
+		}
+
+	}
+	{
+
+		v := 0 | (uint64(buf[i+0+0]) << 0) | (uint64(buf[i+1+0]) << 8) | (uint64(buf[i+2+0]) << 16) | (uint64(buf[i+3+0]) << 24) | (uint64(buf[i+4+0]) << 32) | (uint64(buf[i+5+0]) << 40) | (uint64(buf[i+6+0]) << 48) | (uint64(buf[i+7+0]) << 56)
+		d.Price = *(*float64)(unsafe.Pointer(&v))
+
+	}
+	return i + 8, nil
+}
+
+type GencodeSignup struct {
+	Name     string
+	Email    string
+	Referrer string
+}
+
+func (d *GencodeSignup) Size() (s uint64) {
+
+	{
+		l := uint64(len(d.Name))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+		l := uint64(len(d.Email))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+		l := uint64(len(d.Referrer))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	return
+}
+func (d *GencodeSignup) Marshal(buf []byte) ([]byte, error) {
+	size := d.Size()
+	{
+		if uint64(cap(buf)) >= size {
+			buf = buf[:size]
+		} else {
+			buf = make([]byte, size)
+		}
+	}
+	i := uint64(0)
+
+	{
+		l := uint64(len(d.Name))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Name)
+		i += l
+	}
+	{
+		l := uint64(len(d.Email))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Email)
+		i += l
+	}
+	{
+		l := uint64(len(d.Referrer))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Referrer)
+		i += l
+	}
+	return buf[:i+0], nil
+}
+
+func (d *GencodeSignup) Unmarshal(buf []byte) (uint64, error) {
+	i := uint64(0)
+
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Name = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Email = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Referrer = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	return i + 0, nil
+}
+
+type GencodeNode struct {
+	Name     string
+	Value    int64
+	Children []GencodeNode
+}
+
+func (d *GencodeNode) Size() (s uint64) {
+
+	{
+		l := uint64(len(d.Name))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+		s += l
+	}
+	{
+
+		t := uint64(d.Value)
+		t <<= 1
+		if d.Value < 0 {
+			t = ^t
+		}
+		for t >= 0x80 {
+			t >>= 7
+			s++
+		}
+		s++
+
+	}
+	{
+		l := uint64(len(d.Children))
+
+		{
+
+			t := l
+			for t >= 0x80 {
+				t >>= 7
+				s++
+			}
+			s++
+
+		}
+
+		for k0 := range d.Children {
+
+			{
+				s += d.Children[k0].Size()
+			}
+
+		}
+
+	}
+	return
+}
+func (d *GencodeNode) Marshal(buf []byte) ([]byte, error) {
+	size := d.Size()
+	{
+		if uint64(cap(buf)) >= size {
+			buf = buf[:size]
+		} else {
+			buf = make([]byte, size)
+		}
+	}
+	i := uint64(0)
+
+	{
+		l := uint64(len(d.Name))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		copy(buf[i+0:], d.Name)
+		i += l
+	}
+	{
+
+		t := uint64(d.Value)
+
+		t <<= 1
+		if d.Value < 0 {
+			t = ^t
+		}
+
+		for t >= 0x80 {
+			buf[i+0] = byte(t) | 0x80
+			t >>= 7
+			i++
+		}
+		buf[i+0] = byte(t)
+		i++
+
+	}
+	{
+		l := uint64(len(d.Children))
+
+		{
+
+			t := uint64(l)
+
+			for t >= 0x80 {
+				buf[i+0] = byte(t) | 0x80
+				t >>= 7
+				i++
+			}
+			buf[i+0] = byte(t)
+			i++
+
+		}
+		for k0 := range d.Children {
+
+			{
+				nbuf, err := d.Children[k0].Marshal(buf[i+0:])
+				if err != nil {
+					return nil, err
+				}
+				i += uint64(len(nbuf))
+			}
+
+		}
+	}
+	return buf[:i+0], nil
+}
+
+func (d *GencodeNode) Unmarshal(buf []byte) (uint64, error) {
+	i := uint64(0)
+
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		d.Name = string(buf[i+0 : i+0+l])
+		i += l
+	}
+	{
+
+		bs := uint8(7)
+		t := uint64(buf[i+0] & 0x7F)
+		for buf[i+0]&0x80 == 0x80 {
+			i++
+			t |= uint64(buf[i+0]&0x7F) << bs
+			bs += 7
+		}
+		i++
+
+		d.Value = int64(t >> 1)
+		if t&1 != 0 {
+			d.Value = ^d.Value
+		}
+
+	}
+	{
+		l := uint64(0)
+
+		{
+
+			bs := uint8(7)
+			t := uint64(buf[i+0] & 0x7F)
+			for buf[i+0]&0x80 == 0x80 {
+				i++
+				t |= uint64(buf[i+0]&0x7F) << bs
+				bs += 7
+			}
+			i++
+
+			l = t
+
+		}
+		if uint64(cap(d.Children)) >= l {
+			d.Children = d.Children[:l]
+		} else {
+			d.Children = make([]GencodeNode, l)
+		}
+		for k0 := range d.Children {
+
+			{
+				ni, err := d.Children[k0].Unmarshal(buf[i+0:])
+				if err != nil {
+					return 0, err
+				}
+				i += ni
+			}
+
+		}
+	}
+	return i + 0, nil
+}
@@ -0,0 +1,136 @@
+package goserbench
+
+import (
+	"flag"
+	"math"
+	"math/bits"
+	"os"
+	"testing"
+	"time"
+)
+
+// latency turns on timing every operation of the Marshal, MarshalReuse,
+// Unmarshal and RoundTrip loops, which then report percentiles next to the
+// mean ns/op.
+var latency = flag.Bool("latency", os.Getenv("LATENCY") != "", "report latency percentiles of every benchmark (default true if $LATENCY is set)")
+
+// subBuckets is the number of buckets each power of two is split into. A
+// duration falls into a bucket at most 1/subBuckets of its value wide, so
+// percentiles are exact up to subBuckets ns and within about 3% beyond.
+const (
+	subBits    = 5
+	subBuckets = 1 << subBits
+)
+
+// latencies is a histogram of operation durations in nanoseconds, of fixed
+// size so that recording allocates nothing and grows no heap for the GC to
+// scan while the benchmark runs.
+type latencies struct {
+	counts [subBuckets + (64-subBits)*subBuckets]uint64
+	n      uint64
+	max    int64
+}
+
+// newLatencies returns an empty histogram, or nil if -latency is not set.
+// All methods of latencies do nothing on nil.
+func newLatencies() *latencies {
+	if !*latency {
+		return nil
+	}
+	return &latencies{}
+}
+
+// bucket returns the index of the bucket of v: v itself below subBuckets,
+// above that its power of two and the next subBits bits below the top one.
+func bucket(v int64) int {
+	if v < subBuckets {
+		if v < 0 {
+			return 0
+		}
+		return int(v)
+	}
+	shift := bits.Len64(uint64(v)) - subBits - 1
+	return shift*subBuckets + int(v>>uint(shift))
+}
+
+// bucketValue returns the middle of bucket i.
+func bucketValue(i int) int64 {
+	if i < subBuckets {
+		return int64(i)
+	}
+	shift := uint((i - subBuckets) / subBuckets)
+	low := int64(subBuckets+(i-subBuckets)%subBuckets) << shift
+	return low + (int64(1)<<shift)/2
+}
+
+// start returns the time an operation starts, or the zero time on nil, so
+// that the loops read no clock unless -latency is set.
+func (h *latencies) start() time.Time {
+	if h == nil {
+		return time.Time{}
+	}
+	return time.Now()
+}
+
+// record adds the time passed since start.
+func (h *latencies) record(start time.Time) {
+	if h == nil {
+		return
+	}
+	d := int64(time.Since(start))
+	h.counts[bucket(d)]++
+	h.n++
+	if d > h.max {
+		h.max = d
+	}
+}
+
+// quantile returns the duration below which a share q of the recorded ones
+// fall.
+func (h *latencies) quantile(q float64) int64 {
+	rank := uint64(math.Ceil(q * float64(h.n)))
+	if rank == 0 {
+		rank = 1
+	}
+	var seen uint64
+	for i, c := range h.counts {
+		seen += c
+		if seen >= rank {
+			if v := bucketValue(i); v < h.max {
+				return v
+			}
+			return h.max
+		}
+	}
+	return h.max
+}
+
+// report reports the percentiles and the maximum as custom metrics. They
+// include the harness and reading the clock, which the Baseline shows.
+func (h *latencies) report(b *testing.B) {
+	if h == nil || h.n == 0 {
+		return
+	}
+	b.ReportMetric(float64(h.quantile(0.5)), "p50-ns")
+	b.ReportMetric(float64(h.quantile(0.9)), "p90-ns")
+	b.ReportMetric(float64(h.quantile(0.99)), "p99-ns")
+	b.ReportMetric(float64(h.quantile(0.999)), "p99.9-ns")
+	b.ReportMetric(float64(h.max), "max-ns")
+}
+
+// TestLatencies checks the percentiles of durations 1 to 100000 ns against
+// the bucket error bound.
+func TestLatencies(t *testing.T) {
+	h := &latencies{}
+	for v := int64(1); v <= 100000; v++ {
+		h.counts[bucket(v)]++
+		h.n++
+		h.max = v
+	}
+	for _, q := range []float64{0.5, 0.9, 0.99, 0.999, 1} {
+		want := q * 100000
+		if got := float64(h.quantile(q)); math.Abs(got-want) > want/subBuckets {
+			t.Errorf("quantile %g = %g, want %g", q, got, want)
+		}
+	}
+}
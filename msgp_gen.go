@@ -234,6 +234,1376 @@ func (z *A) Msgsize() (s int) {
 	return
 }
 
+// DecodeMsg implements msgp.Decodable
+func (z *Address) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Street":
+			z.Street, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Street")
+				return
+			}
+		case "City":
+			z.City, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "City")
+				return
+			}
+		case "Zip":
+			z.Zip, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Zip")
+				return
+			}
+		case "Country":
+			z.Country, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Country")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *Address) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 4
+	// write "Street"
+	err = en.Append(0x84, 0xa6, 0x53, 0x74, 0x72, 0x65, 0x65, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Street)
+	if err != nil {
+		err = msgp.WrapError(err, "Street")
+		return
+	}
+	// write "City"
+	err = en.Append(0xa4, 0x43, 0x69, 0x74, 0x79)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.City)
+	if err != nil {
+		err = msgp.WrapError(err, "City")
+		return
+	}
+	// write "Zip"
+	err = en.Append(0xa3, 0x5a, 0x69, 0x70)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Zip)
+	if err != nil {
+		err = msgp.WrapError(err, "Zip")
+		return
+	}
+	// write "Country"
+	err = en.Append(0xa7, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Country)
+	if err != nil {
+		err = msgp.WrapError(err, "Country")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Address) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 4
+	// string "Street"
+	o = append(o, 0x84, 0xa6, 0x53, 0x74, 0x72, 0x65, 0x65, 0x74)
+	o = msgp.AppendString(o, z.Street)
+	// string "City"
+	o = append(o, 0xa4, 0x43, 0x69, 0x74, 0x79)
+	o = msgp.AppendString(o, z.City)
+	// string "Zip"
+	o = append(o, 0xa3, 0x5a, 0x69, 0x70)
+	o = msgp.AppendString(o, z.Zip)
+	// string "Country"
+	o = append(o, 0xa7, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79)
+	o = msgp.AppendString(o, z.Country)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Address) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Street":
+			z.Street, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Street")
+				return
+			}
+		case "City":
+			z.City, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "City")
+				return
+			}
+		case "Zip":
+			z.Zip, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Zip")
+				return
+			}
+		case "Country":
+			z.Country, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Country")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Address) Msgsize() (s int) {
+	s = 1 + 7 + msgp.StringPrefixSize + len(z.Street) + 5 + msgp.StringPrefixSize + len(z.City) + 4 + msgp.StringPrefixSize + len(z.Zip) + 8 + msgp.StringPrefixSize + len(z.Country)
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *Batch) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Records":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Records")
+				return
+			}
+			if cap(z.Records) >= int(zb0002) {
+				z.Records = (z.Records)[:zb0002]
+			} else {
+				z.Records = make([]A, zb0002)
+			}
+			for za0001 := range z.Records {
+				err = z.Records[za0001].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "Records", za0001)
+					return
+				}
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *Batch) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 1
+	// write "Records"
+	err = en.Append(0x81, 0xa7, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.Records)))
+	if err != nil {
+		err = msgp.WrapError(err, "Records")
+		return
+	}
+	for za0001 := range z.Records {
+		err = z.Records[za0001].EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Records", za0001)
+			return
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Batch) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 1
+	// string "Records"
+	o = append(o, 0x81, 0xa7, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Records)))
+	for za0001 := range z.Records {
+		o, err = z.Records[za0001].MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Records", za0001)
+			return
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Batch) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Records":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Records")
+				return
+			}
+			if cap(z.Records) >= int(zb0002) {
+				z.Records = (z.Records)[:zb0002]
+			} else {
+				z.Records = make([]A, zb0002)
+			}
+			for za0001 := range z.Records {
+				bts, err = z.Records[za0001].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Records", za0001)
+					return
+				}
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Batch) Msgsize() (s int) {
+	s = 1 + 8 + msgp.ArrayHeaderSize
+	for za0001 := range z.Records {
+		s += z.Records[za0001].Msgsize()
+	}
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *Blob) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Name":
+			z.Name, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Name")
+				return
+			}
+		case "Data":
+			z.Data, err = dc.ReadBytes(z.Data)
+			if err != nil {
+				err = msgp.WrapError(err, "Data")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *Blob) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 2
+	// write "Name"
+	err = en.Append(0x82, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Name)
+	if err != nil {
+		err = msgp.WrapError(err, "Name")
+		return
+	}
+	// write "Data"
+	err = en.Append(0xa4, 0x44, 0x61, 0x74, 0x61)
+	if err != nil {
+		return
+	}
+	err = en.WriteBytes(z.Data)
+	if err != nil {
+		err = msgp.WrapError(err, "Data")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Blob) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 2
+	// string "Name"
+	o = append(o, 0x82, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	o = msgp.AppendString(o, z.Name)
+	// string "Data"
+	o = append(o, 0xa4, 0x44, 0x61, 0x74, 0x61)
+	o = msgp.AppendBytes(o, z.Data)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Blob) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Name":
+			z.Name, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Name")
+				return
+			}
+		case "Data":
+			z.Data, bts, err = msgp.ReadBytesBytes(bts, z.Data)
+			if err != nil {
+				err = msgp.WrapError(err, "Data")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Blob) Msgsize() (s int) {
+	s = 1 + 5 + msgp.StringPrefixSize + len(z.Name) + 5 + msgp.BytesPrefixSize + len(z.Data)
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *Child) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Name":
+			z.Name, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Name")
+				return
+			}
+		case "BirthDay":
+			z.BirthDay, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "BirthDay")
+				return
+			}
+		case "Grade":
+			z.Grade, err = dc.ReadInt()
+			if err != nil {
+				err = msgp.WrapError(err, "Grade")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z Child) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 3
+	// write "Name"
+	err = en.Append(0x83, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Name)
+	if err != nil {
+		err = msgp.WrapError(err, "Name")
+		return
+	}
+	// write "BirthDay"
+	err = en.Append(0xa8, 0x42, 0x69, 0x72, 0x74, 0x68, 0x44, 0x61, 0x79)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.BirthDay)
+	if err != nil {
+		err = msgp.WrapError(err, "BirthDay")
+		return
+	}
+	// write "Grade"
+	err = en.Append(0xa5, 0x47, 0x72, 0x61, 0x64, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(z.Grade)
+	if err != nil {
+		err = msgp.WrapError(err, "Grade")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z Child) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 3
+	// string "Name"
+	o = append(o, 0x83, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	o = msgp.AppendString(o, z.Name)
+	// string "BirthDay"
+	o = append(o, 0xa8, 0x42, 0x69, 0x72, 0x74, 0x68, 0x44, 0x61, 0x79)
+	o = msgp.AppendTime(o, z.BirthDay)
+	// string "Grade"
+	o = append(o, 0xa5, 0x47, 0x72, 0x61, 0x64, 0x65)
+	o = msgp.AppendInt(o, z.Grade)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Child) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Name":
+			z.Name, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Name")
+				return
+			}
+		case "BirthDay":
+			z.BirthDay, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "BirthDay")
+				return
+			}
+		case "Grade":
+			z.Grade, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Grade")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z Child) Msgsize() (s int) {
+	s = 1 + 5 + msgp.StringPrefixSize + len(z.Name) + 9 + msgp.TimeSize + 6 + msgp.IntSize
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *Click) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Page":
+			z.Page, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Page")
+				return
+			}
+		case "X":
+			z.X, err = dc.ReadInt()
+			if err != nil {
+				err = msgp.WrapError(err, "X")
+				return
+			}
+		case "Y":
+			z.Y, err = dc.ReadInt()
+			if err != nil {
+				err = msgp.WrapError(err, "Y")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z Click) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 3
+	// write "Page"
+	err = en.Append(0x83, 0xa4, 0x50, 0x61, 0x67, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Page)
+	if err != nil {
+		err = msgp.WrapError(err, "Page")
+		return
+	}
+	// write "X"
+	err = en.Append(0xa1, 0x58)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(z.X)
+	if err != nil {
+		err = msgp.WrapError(err, "X")
+		return
+	}
+	// write "Y"
+	err = en.Append(0xa1, 0x59)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(z.Y)
+	if err != nil {
+		err = msgp.WrapError(err, "Y")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z Click) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 3
+	// string "Page"
+	o = append(o, 0x83, 0xa4, 0x50, 0x61, 0x67, 0x65)
+	o = msgp.AppendString(o, z.Page)
+	// string "X"
+	o = append(o, 0xa1, 0x58)
+	o = msgp.AppendInt(o, z.X)
+	// string "Y"
+	o = append(o, 0xa1, 0x59)
+	o = msgp.AppendInt(o, z.Y)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Click) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Page":
+			z.Page, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Page")
+				return
+			}
+		case "X":
+			z.X, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "X")
+				return
+			}
+		case "Y":
+			z.Y, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Y")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z Click) Msgsize() (s int) {
+	s = 1 + 5 + msgp.StringPrefixSize + len(z.Page) + 2 + msgp.IntSize + 2 + msgp.IntSize
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *EventEnvelope) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "ID":
+			z.ID, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "ID")
+				return
+			}
+		case "Click":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "Click")
+					return
+				}
+				z.Click = nil
+			} else {
+				if z.Click == nil {
+					z.Click = new(Click)
+				}
+				var zb0002 uint32
+				zb0002, err = dc.ReadMapHeader()
+				if err != nil {
+					err = msgp.WrapError(err, "Click")
+					return
+				}
+				for zb0002 > 0 {
+					zb0002--
+					field, err = dc.ReadMapKeyPtr()
+					if err != nil {
+						err = msgp.WrapError(err, "Click")
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "Page":
+						z.Click.Page, err = dc.ReadString()
+						if err != nil {
+							err = msgp.WrapError(err, "Click", "Page")
+							return
+						}
+					case "X":
+						z.Click.X, err = dc.ReadInt()
+						if err != nil {
+							err = msgp.WrapError(err, "Click", "X")
+							return
+						}
+					case "Y":
+						z.Click.Y, err = dc.ReadInt()
+						if err != nil {
+							err = msgp.WrapError(err, "Click", "Y")
+							return
+						}
+					default:
+						err = dc.Skip()
+						if err != nil {
+							err = msgp.WrapError(err, "Click")
+							return
+						}
+					}
+				}
+			}
+		case "Purchase":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "Purchase")
+					return
+				}
+				z.Purchase = nil
+			} else {
+				if z.Purchase == nil {
+					z.Purchase = new(Purchase)
+				}
+				var zb0003 uint32
+				zb0003, err = dc.ReadMapHeader()
+				if err != nil {
+					err = msgp.WrapError(err, "Purchase")
+					return
+				}
+				for zb0003 > 0 {
+					zb0003--
+					field, err = dc.ReadMapKeyPtr()
+					if err != nil {
+						err = msgp.WrapError(err, "Purchase")
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "Item":
+						z.Purchase.Item, err = dc.ReadString()
+						if err != nil {
+							err = msgp.WrapError(err, "Purchase", "Item")
+							return
+						}
+					case "Quantity":
+						z.Purchase.Quantity, err = dc.ReadInt()
+						if err != nil {
+							err = msgp.WrapError(err, "Purchase", "Quantity")
+							return
+						}
+					case "Price":
+						z.Purchase.Price, err = dc.ReadFloat64()
+						if err != nil {
+							err = msgp.WrapError(err, "Purchase", "Price")
+							return
+						}
+					default:
+						err = dc.Skip()
+						if err != nil {
+							err = msgp.WrapError(err, "Purchase")
+							return
+						}
+					}
+				}
+			}
+		case "Signup":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "Signup")
+					return
+				}
+				z.Signup = nil
+			} else {
+				if z.Signup == nil {
+					z.Signup = new(Signup)
+				}
+				var zb0004 uint32
+				zb0004, err = dc.ReadMapHeader()
+				if err != nil {
+					err = msgp.WrapError(err, "Signup")
+					return
+				}
+				for zb0004 > 0 {
+					zb0004--
+					field, err = dc.ReadMapKeyPtr()
+					if err != nil {
+						err = msgp.WrapError(err, "Signup")
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "Name":
+						z.Signup.Name, err = dc.ReadString()
+						if err != nil {
+							err = msgp.WrapError(err, "Signup", "Name")
+							return
+						}
+					case "Email":
+						z.Signup.Email, err = dc.ReadString()
+						if err != nil {
+							err = msgp.WrapError(err, "Signup", "Email")
+							return
+						}
+					case "Referrer":
+						z.Signup.Referrer, err = dc.ReadString()
+						if err != nil {
+							err = msgp.WrapError(err, "Signup", "Referrer")
+							return
+						}
+					default:
+						err = dc.Skip()
+						if err != nil {
+							err = msgp.WrapError(err, "Signup")
+							return
+						}
+					}
+				}
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *EventEnvelope) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 4
+	// write "ID"
+	err = en.Append(0x84, 0xa2, 0x49, 0x44)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.ID)
+	if err != nil {
+		err = msgp.WrapError(err, "ID")
+		return
+	}
+	// write "Click"
+	err = en.Append(0xa5, 0x43, 0x6c, 0x69, 0x63, 0x6b)
+	if err != nil {
+		return
+	}
+	if z.Click == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		// map header, size 3
+		// write "Page"
+		err = en.Append(0x83, 0xa4, 0x50, 0x61, 0x67, 0x65)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Click.Page)
+		if err != nil {
+			err = msgp.WrapError(err, "Click", "Page")
+			return
+		}
+		// write "X"
+		err = en.Append(0xa1, 0x58)
+		if err != nil {
+			return
+		}
+		err = en.WriteInt(z.Click.X)
+		if err != nil {
+			err = msgp.WrapError(err, "Click", "X")
+			return
+		}
+		// write "Y"
+		err = en.Append(0xa1, 0x59)
+		if err != nil {
+			return
+		}
+		err = en.WriteInt(z.Click.Y)
+		if err != nil {
+			err = msgp.WrapError(err, "Click", "Y")
+			return
+		}
+	}
+	// write "Purchase"
+	err = en.Append(0xa8, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65)
+	if err != nil {
+		return
+	}
+	if z.Purchase == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		// map header, size 3
+		// write "Item"
+		err = en.Append(0x83, 0xa4, 0x49, 0x74, 0x65, 0x6d)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Purchase.Item)
+		if err != nil {
+			err = msgp.WrapError(err, "Purchase", "Item")
+			return
+		}
+		// write "Quantity"
+		err = en.Append(0xa8, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79)
+		if err != nil {
+			return
+		}
+		err = en.WriteInt(z.Purchase.Quantity)
+		if err != nil {
+			err = msgp.WrapError(err, "Purchase", "Quantity")
+			return
+		}
+		// write "Price"
+		err = en.Append(0xa5, 0x50, 0x72, 0x69, 0x63, 0x65)
+		if err != nil {
+			return
+		}
+		err = en.WriteFloat64(z.Purchase.Price)
+		if err != nil {
+			err = msgp.WrapError(err, "Purchase", "Price")
+			return
+		}
+	}
+	// write "Signup"
+	err = en.Append(0xa6, 0x53, 0x69, 0x67, 0x6e, 0x75, 0x70)
+	if err != nil {
+		return
+	}
+	if z.Signup == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		// map header, size 3
+		// write "Name"
+		err = en.Append(0x83, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Signup.Name)
+		if err != nil {
+			err = msgp.WrapError(err, "Signup", "Name")
+			return
+		}
+		// write "Email"
+		err = en.Append(0xa5, 0x45, 0x6d, 0x61, 0x69, 0x6c)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Signup.Email)
+		if err != nil {
+			err = msgp.WrapError(err, "Signup", "Email")
+			return
+		}
+		// write "Referrer"
+		err = en.Append(0xa8, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Signup.Referrer)
+		if err != nil {
+			err = msgp.WrapError(err, "Signup", "Referrer")
+			return
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *EventEnvelope) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 4
+	// string "ID"
+	o = append(o, 0x84, 0xa2, 0x49, 0x44)
+	o = msgp.AppendInt64(o, z.ID)
+	// string "Click"
+	o = append(o, 0xa5, 0x43, 0x6c, 0x69, 0x63, 0x6b)
+	if z.Click == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		// map header, size 3
+		// string "Page"
+		o = append(o, 0x83, 0xa4, 0x50, 0x61, 0x67, 0x65)
+		o = msgp.AppendString(o, z.Click.Page)
+		// string "X"
+		o = append(o, 0xa1, 0x58)
+		o = msgp.AppendInt(o, z.Click.X)
+		// string "Y"
+		o = append(o, 0xa1, 0x59)
+		o = msgp.AppendInt(o, z.Click.Y)
+	}
+	// string "Purchase"
+	o = append(o, 0xa8, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65)
+	if z.Purchase == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		// map header, size 3
+		// string "Item"
+		o = append(o, 0x83, 0xa4, 0x49, 0x74, 0x65, 0x6d)
+		o = msgp.AppendString(o, z.Purchase.Item)
+		// string "Quantity"
+		o = append(o, 0xa8, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79)
+		o = msgp.AppendInt(o, z.Purchase.Quantity)
+		// string "Price"
+		o = append(o, 0xa5, 0x50, 0x72, 0x69, 0x63, 0x65)
+		o = msgp.AppendFloat64(o, z.Purchase.Price)
+	}
+	// string "Signup"
+	o = append(o, 0xa6, 0x53, 0x69, 0x67, 0x6e, 0x75, 0x70)
+	if z.Signup == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		// map header, size 3
+		// string "Name"
+		o = append(o, 0x83, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+		o = msgp.AppendString(o, z.Signup.Name)
+		// string "Email"
+		o = append(o, 0xa5, 0x45, 0x6d, 0x61, 0x69, 0x6c)
+		o = msgp.AppendString(o, z.Signup.Email)
+		// string "Referrer"
+		o = append(o, 0xa8, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72)
+		o = msgp.AppendString(o, z.Signup.Referrer)
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *EventEnvelope) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "ID":
+			z.ID, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ID")
+				return
+			}
+		case "Click":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.Click = nil
+			} else {
+				if z.Click == nil {
+					z.Click = new(Click)
+				}
+				var zb0002 uint32
+				zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Click")
+					return
+				}
+				for zb0002 > 0 {
+					zb0002--
+					field, bts, err = msgp.ReadMapKeyZC(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Click")
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "Page":
+						z.Click.Page, bts, err = msgp.ReadStringBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Click", "Page")
+							return
+						}
+					case "X":
+						z.Click.X, bts, err = msgp.ReadIntBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Click", "X")
+							return
+						}
+					case "Y":
+						z.Click.Y, bts, err = msgp.ReadIntBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Click", "Y")
+							return
+						}
+					default:
+						bts, err = msgp.Skip(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Click")
+							return
+						}
+					}
+				}
+			}
+		case "Purchase":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.Purchase = nil
+			} else {
+				if z.Purchase == nil {
+					z.Purchase = new(Purchase)
+				}
+				var zb0003 uint32
+				zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Purchase")
+					return
+				}
+				for zb0003 > 0 {
+					zb0003--
+					field, bts, err = msgp.ReadMapKeyZC(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Purchase")
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "Item":
+						z.Purchase.Item, bts, err = msgp.ReadStringBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Purchase", "Item")
+							return
+						}
+					case "Quantity":
+						z.Purchase.Quantity, bts, err = msgp.ReadIntBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Purchase", "Quantity")
+							return
+						}
+					case "Price":
+						z.Purchase.Price, bts, err = msgp.ReadFloat64Bytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Purchase", "Price")
+							return
+						}
+					default:
+						bts, err = msgp.Skip(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Purchase")
+							return
+						}
+					}
+				}
+			}
+		case "Signup":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.Signup = nil
+			} else {
+				if z.Signup == nil {
+					z.Signup = new(Signup)
+				}
+				var zb0004 uint32
+				zb0004, bts, err = msgp.ReadMapHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Signup")
+					return
+				}
+				for zb0004 > 0 {
+					zb0004--
+					field, bts, err = msgp.ReadMapKeyZC(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Signup")
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "Name":
+						z.Signup.Name, bts, err = msgp.ReadStringBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Signup", "Name")
+							return
+						}
+					case "Email":
+						z.Signup.Email, bts, err = msgp.ReadStringBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Signup", "Email")
+							return
+						}
+					case "Referrer":
+						z.Signup.Referrer, bts, err = msgp.ReadStringBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Signup", "Referrer")
+							return
+						}
+					default:
+						bts, err = msgp.Skip(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Signup")
+							return
+						}
+					}
+				}
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *EventEnvelope) Msgsize() (s int) {
+	s = 1 + 3 + msgp.Int64Size + 6
+	if z.Click == nil {
+		s += msgp.NilSize
+	} else {
+		s += 1 + 5 + msgp.StringPrefixSize + len(z.Click.Page) + 2 + msgp.IntSize + 2 + msgp.IntSize
+	}
+	s += 9
+	if z.Purchase == nil {
+		s += msgp.NilSize
+	} else {
+		s += 1 + 5 + msgp.StringPrefixSize + len(z.Purchase.Item) + 9 + msgp.IntSize + 6 + msgp.Float64Size
+	}
+	s += 7
+	if z.Signup == nil {
+		s += msgp.NilSize
+	} else {
+		s += 1 + 5 + msgp.StringPrefixSize + len(z.Signup.Name) + 6 + msgp.StringPrefixSize + len(z.Signup.Email) + 9 + msgp.StringPrefixSize + len(z.Signup.Referrer)
+	}
+	return
+}
+
 // DecodeMsg implements msgp.Decodable
 func (z *NoTimeA) DecodeMsg(dc *msgp.Reader) (err error) {
 	var field []byte
@@ -259,7 +1629,1566 @@ func (z *NoTimeA) DecodeMsg(dc *msgp.Reader) (err error) {
 				return
 			}
 		case "BirthDay":
-			z.BirthDay, err = dc.ReadInt64()
+			z.BirthDay, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "BirthDay")
+				return
+			}
+		case "Phone":
+			z.Phone, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Phone")
+				return
+			}
+		case "Siblings":
+			z.Siblings, err = dc.ReadInt()
+			if err != nil {
+				err = msgp.WrapError(err, "Siblings")
+				return
+			}
+		case "Spouse":
+			z.Spouse, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "Spouse")
+				return
+			}
+		case "Money":
+			z.Money, err = dc.ReadFloat64()
+			if err != nil {
+				err = msgp.WrapError(err, "Money")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *NoTimeA) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 6
+	// write "Name"
+	err = en.Append(0x86, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Name)
+	if err != nil {
+		err = msgp.WrapError(err, "Name")
+		return
+	}
+	// write "BirthDay"
+	err = en.Append(0xa8, 0x42, 0x69, 0x72, 0x74, 0x68, 0x44, 0x61, 0x79)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.BirthDay)
+	if err != nil {
+		err = msgp.WrapError(err, "BirthDay")
+		return
+	}
+	// write "Phone"
+	err = en.Append(0xa5, 0x50, 0x68, 0x6f, 0x6e, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Phone)
+	if err != nil {
+		err = msgp.WrapError(err, "Phone")
+		return
+	}
+	// write "Siblings"
+	err = en.Append(0xa8, 0x53, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(z.Siblings)
+	if err != nil {
+		err = msgp.WrapError(err, "Siblings")
+		return
+	}
+	// write "Spouse"
+	err = en.Append(0xa6, 0x53, 0x70, 0x6f, 0x75, 0x73, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.Spouse)
+	if err != nil {
+		err = msgp.WrapError(err, "Spouse")
+		return
+	}
+	// write "Money"
+	err = en.Append(0xa5, 0x4d, 0x6f, 0x6e, 0x65, 0x79)
+	if err != nil {
+		return
+	}
+	err = en.WriteFloat64(z.Money)
+	if err != nil {
+		err = msgp.WrapError(err, "Money")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *NoTimeA) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 6
+	// string "Name"
+	o = append(o, 0x86, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	o = msgp.AppendString(o, z.Name)
+	// string "BirthDay"
+	o = append(o, 0xa8, 0x42, 0x69, 0x72, 0x74, 0x68, 0x44, 0x61, 0x79)
+	o = msgp.AppendInt64(o, z.BirthDay)
+	// string "Phone"
+	o = append(o, 0xa5, 0x50, 0x68, 0x6f, 0x6e, 0x65)
+	o = msgp.AppendString(o, z.Phone)
+	// string "Siblings"
+	o = append(o, 0xa8, 0x53, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x73)
+	o = msgp.AppendInt(o, z.Siblings)
+	// string "Spouse"
+	o = append(o, 0xa6, 0x53, 0x70, 0x6f, 0x75, 0x73, 0x65)
+	o = msgp.AppendBool(o, z.Spouse)
+	// string "Money"
+	o = append(o, 0xa5, 0x4d, 0x6f, 0x6e, 0x65, 0x79)
+	o = msgp.AppendFloat64(o, z.Money)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *NoTimeA) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Name":
+			z.Name, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Name")
+				return
+			}
+		case "BirthDay":
+			z.BirthDay, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "BirthDay")
+				return
+			}
+		case "Phone":
+			z.Phone, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Phone")
+				return
+			}
+		case "Siblings":
+			z.Siblings, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Siblings")
+				return
+			}
+		case "Spouse":
+			z.Spouse, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Spouse")
+				return
+			}
+		case "Money":
+			z.Money, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Money")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *NoTimeA) Msgsize() (s int) {
+	s = 1 + 5 + msgp.StringPrefixSize + len(z.Name) + 9 + msgp.Int64Size + 6 + msgp.StringPrefixSize + len(z.Phone) + 9 + msgp.IntSize + 7 + msgp.BoolSize + 6 + msgp.Float64Size
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *Node) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Name":
+			z.Name, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Name")
+				return
+			}
+		case "Value":
+			z.Value, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "Value")
+				return
+			}
+		case "Children":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Children")
+				return
+			}
+			if cap(z.Children) >= int(zb0002) {
+				z.Children = (z.Children)[:zb0002]
+			} else {
+				z.Children = make([]Node, zb0002)
+			}
+			for za0001 := range z.Children {
+				err = z.Children[za0001].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "Children", za0001)
+					return
+				}
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *Node) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 3
+	// write "Name"
+	err = en.Append(0x83, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Name)
+	if err != nil {
+		err = msgp.WrapError(err, "Name")
+		return
+	}
+	// write "Value"
+	err = en.Append(0xa5, 0x56, 0x61, 0x6c, 0x75, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.Value)
+	if err != nil {
+		err = msgp.WrapError(err, "Value")
+		return
+	}
+	// write "Children"
+	err = en.Append(0xa8, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.Children)))
+	if err != nil {
+		err = msgp.WrapError(err, "Children")
+		return
+	}
+	for za0001 := range z.Children {
+		err = z.Children[za0001].EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Children", za0001)
+			return
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Node) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 3
+	// string "Name"
+	o = append(o, 0x83, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	o = msgp.AppendString(o, z.Name)
+	// string "Value"
+	o = append(o, 0xa5, 0x56, 0x61, 0x6c, 0x75, 0x65)
+	o = msgp.AppendInt64(o, z.Value)
+	// string "Children"
+	o = append(o, 0xa8, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Children)))
+	for za0001 := range z.Children {
+		o, err = z.Children[za0001].MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Children", za0001)
+			return
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Node) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Name":
+			z.Name, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Name")
+				return
+			}
+		case "Value":
+			z.Value, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Value")
+				return
+			}
+		case "Children":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Children")
+				return
+			}
+			if cap(z.Children) >= int(zb0002) {
+				z.Children = (z.Children)[:zb0002]
+			} else {
+				z.Children = make([]Node, zb0002)
+			}
+			for za0001 := range z.Children {
+				bts, err = z.Children[za0001].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Children", za0001)
+					return
+				}
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Node) Msgsize() (s int) {
+	s = 1 + 5 + msgp.StringPrefixSize + len(z.Name) + 6 + msgp.Int64Size + 9 + msgp.ArrayHeaderSize
+	for za0001 := range z.Children {
+		s += z.Children[za0001].Msgsize()
+	}
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *OmitEmptyA) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Name":
+			z.Name, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Name")
+				return
+			}
+		case "BirthDay":
+			z.BirthDay, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "BirthDay")
+				return
+			}
+		case "Phone":
+			z.Phone, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Phone")
+				return
+			}
+		case "Siblings":
+			z.Siblings, err = dc.ReadInt()
+			if err != nil {
+				err = msgp.WrapError(err, "Siblings")
+				return
+			}
+		case "Spouse":
+			z.Spouse, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "Spouse")
+				return
+			}
+		case "Money":
+			z.Money, err = dc.ReadFloat64()
+			if err != nil {
+				err = msgp.WrapError(err, "Money")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *OmitEmptyA) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 6
+	// write "Name"
+	err = en.Append(0x86, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Name)
+	if err != nil {
+		err = msgp.WrapError(err, "Name")
+		return
+	}
+	// write "BirthDay"
+	err = en.Append(0xa8, 0x42, 0x69, 0x72, 0x74, 0x68, 0x44, 0x61, 0x79)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.BirthDay)
+	if err != nil {
+		err = msgp.WrapError(err, "BirthDay")
+		return
+	}
+	// write "Phone"
+	err = en.Append(0xa5, 0x50, 0x68, 0x6f, 0x6e, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Phone)
+	if err != nil {
+		err = msgp.WrapError(err, "Phone")
+		return
+	}
+	// write "Siblings"
+	err = en.Append(0xa8, 0x53, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(z.Siblings)
+	if err != nil {
+		err = msgp.WrapError(err, "Siblings")
+		return
+	}
+	// write "Spouse"
+	err = en.Append(0xa6, 0x53, 0x70, 0x6f, 0x75, 0x73, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.Spouse)
+	if err != nil {
+		err = msgp.WrapError(err, "Spouse")
+		return
+	}
+	// write "Money"
+	err = en.Append(0xa5, 0x4d, 0x6f, 0x6e, 0x65, 0x79)
+	if err != nil {
+		return
+	}
+	err = en.WriteFloat64(z.Money)
+	if err != nil {
+		err = msgp.WrapError(err, "Money")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *OmitEmptyA) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 6
+	// string "Name"
+	o = append(o, 0x86, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	o = msgp.AppendString(o, z.Name)
+	// string "BirthDay"
+	o = append(o, 0xa8, 0x42, 0x69, 0x72, 0x74, 0x68, 0x44, 0x61, 0x79)
+	o = msgp.AppendTime(o, z.BirthDay)
+	// string "Phone"
+	o = append(o, 0xa5, 0x50, 0x68, 0x6f, 0x6e, 0x65)
+	o = msgp.AppendString(o, z.Phone)
+	// string "Siblings"
+	o = append(o, 0xa8, 0x53, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x73)
+	o = msgp.AppendInt(o, z.Siblings)
+	// string "Spouse"
+	o = append(o, 0xa6, 0x53, 0x70, 0x6f, 0x75, 0x73, 0x65)
+	o = msgp.AppendBool(o, z.Spouse)
+	// string "Money"
+	o = append(o, 0xa5, 0x4d, 0x6f, 0x6e, 0x65, 0x79)
+	o = msgp.AppendFloat64(o, z.Money)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *OmitEmptyA) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Name":
+			z.Name, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Name")
+				return
+			}
+		case "BirthDay":
+			z.BirthDay, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "BirthDay")
+				return
+			}
+		case "Phone":
+			z.Phone, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Phone")
+				return
+			}
+		case "Siblings":
+			z.Siblings, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Siblings")
+				return
+			}
+		case "Spouse":
+			z.Spouse, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Spouse")
+				return
+			}
+		case "Money":
+			z.Money, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Money")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *OmitEmptyA) Msgsize() (s int) {
+	s = 1 + 5 + msgp.StringPrefixSize + len(z.Name) + 9 + msgp.TimeSize + 6 + msgp.StringPrefixSize + len(z.Phone) + 9 + msgp.IntSize + 7 + msgp.BoolSize + 6 + msgp.Float64Size
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *Person) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Name":
+			z.Name, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Name")
+				return
+			}
+		case "BirthDay":
+			z.BirthDay, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "BirthDay")
+				return
+			}
+		case "Phone":
+			z.Phone, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Phone")
+				return
+			}
+		case "Money":
+			z.Money, err = dc.ReadFloat64()
+			if err != nil {
+				err = msgp.WrapError(err, "Money")
+				return
+			}
+		case "Address":
+			err = z.Address.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "Address")
+				return
+			}
+		case "Children":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Children")
+				return
+			}
+			if cap(z.Children) >= int(zb0002) {
+				z.Children = (z.Children)[:zb0002]
+			} else {
+				z.Children = make([]Child, zb0002)
+			}
+			for za0001 := range z.Children {
+				var zb0003 uint32
+				zb0003, err = dc.ReadMapHeader()
+				if err != nil {
+					err = msgp.WrapError(err, "Children", za0001)
+					return
+				}
+				for zb0003 > 0 {
+					zb0003--
+					field, err = dc.ReadMapKeyPtr()
+					if err != nil {
+						err = msgp.WrapError(err, "Children", za0001)
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "Name":
+						z.Children[za0001].Name, err = dc.ReadString()
+						if err != nil {
+							err = msgp.WrapError(err, "Children", za0001, "Name")
+							return
+						}
+					case "BirthDay":
+						z.Children[za0001].BirthDay, err = dc.ReadTime()
+						if err != nil {
+							err = msgp.WrapError(err, "Children", za0001, "BirthDay")
+							return
+						}
+					case "Grade":
+						z.Children[za0001].Grade, err = dc.ReadInt()
+						if err != nil {
+							err = msgp.WrapError(err, "Children", za0001, "Grade")
+							return
+						}
+					default:
+						err = dc.Skip()
+						if err != nil {
+							err = msgp.WrapError(err, "Children", za0001)
+							return
+						}
+					}
+				}
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *Person) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 6
+	// write "Name"
+	err = en.Append(0x86, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Name)
+	if err != nil {
+		err = msgp.WrapError(err, "Name")
+		return
+	}
+	// write "BirthDay"
+	err = en.Append(0xa8, 0x42, 0x69, 0x72, 0x74, 0x68, 0x44, 0x61, 0x79)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.BirthDay)
+	if err != nil {
+		err = msgp.WrapError(err, "BirthDay")
+		return
+	}
+	// write "Phone"
+	err = en.Append(0xa5, 0x50, 0x68, 0x6f, 0x6e, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Phone)
+	if err != nil {
+		err = msgp.WrapError(err, "Phone")
+		return
+	}
+	// write "Money"
+	err = en.Append(0xa5, 0x4d, 0x6f, 0x6e, 0x65, 0x79)
+	if err != nil {
+		return
+	}
+	err = en.WriteFloat64(z.Money)
+	if err != nil {
+		err = msgp.WrapError(err, "Money")
+		return
+	}
+	// write "Address"
+	err = en.Append(0xa7, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73)
+	if err != nil {
+		return
+	}
+	err = z.Address.EncodeMsg(en)
+	if err != nil {
+		err = msgp.WrapError(err, "Address")
+		return
+	}
+	// write "Children"
+	err = en.Append(0xa8, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.Children)))
+	if err != nil {
+		err = msgp.WrapError(err, "Children")
+		return
+	}
+	for za0001 := range z.Children {
+		// map header, size 3
+		// write "Name"
+		err = en.Append(0x83, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Children[za0001].Name)
+		if err != nil {
+			err = msgp.WrapError(err, "Children", za0001, "Name")
+			return
+		}
+		// write "BirthDay"
+		err = en.Append(0xa8, 0x42, 0x69, 0x72, 0x74, 0x68, 0x44, 0x61, 0x79)
+		if err != nil {
+			return
+		}
+		err = en.WriteTime(z.Children[za0001].BirthDay)
+		if err != nil {
+			err = msgp.WrapError(err, "Children", za0001, "BirthDay")
+			return
+		}
+		// write "Grade"
+		err = en.Append(0xa5, 0x47, 0x72, 0x61, 0x64, 0x65)
+		if err != nil {
+			return
+		}
+		err = en.WriteInt(z.Children[za0001].Grade)
+		if err != nil {
+			err = msgp.WrapError(err, "Children", za0001, "Grade")
+			return
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Person) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 6
+	// string "Name"
+	o = append(o, 0x86, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	o = msgp.AppendString(o, z.Name)
+	// string "BirthDay"
+	o = append(o, 0xa8, 0x42, 0x69, 0x72, 0x74, 0x68, 0x44, 0x61, 0x79)
+	o = msgp.AppendTime(o, z.BirthDay)
+	// string "Phone"
+	o = append(o, 0xa5, 0x50, 0x68, 0x6f, 0x6e, 0x65)
+	o = msgp.AppendString(o, z.Phone)
+	// string "Money"
+	o = append(o, 0xa5, 0x4d, 0x6f, 0x6e, 0x65, 0x79)
+	o = msgp.AppendFloat64(o, z.Money)
+	// string "Address"
+	o = append(o, 0xa7, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73)
+	o, err = z.Address.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Address")
+		return
+	}
+	// string "Children"
+	o = append(o, 0xa8, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Children)))
+	for za0001 := range z.Children {
+		// map header, size 3
+		// string "Name"
+		o = append(o, 0x83, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+		o = msgp.AppendString(o, z.Children[za0001].Name)
+		// string "BirthDay"
+		o = append(o, 0xa8, 0x42, 0x69, 0x72, 0x74, 0x68, 0x44, 0x61, 0x79)
+		o = msgp.AppendTime(o, z.Children[za0001].BirthDay)
+		// string "Grade"
+		o = append(o, 0xa5, 0x47, 0x72, 0x61, 0x64, 0x65)
+		o = msgp.AppendInt(o, z.Children[za0001].Grade)
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Person) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Name":
+			z.Name, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Name")
+				return
+			}
+		case "BirthDay":
+			z.BirthDay, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "BirthDay")
+				return
+			}
+		case "Phone":
+			z.Phone, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Phone")
+				return
+			}
+		case "Money":
+			z.Money, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Money")
+				return
+			}
+		case "Address":
+			bts, err = z.Address.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Address")
+				return
+			}
+		case "Children":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Children")
+				return
+			}
+			if cap(z.Children) >= int(zb0002) {
+				z.Children = (z.Children)[:zb0002]
+			} else {
+				z.Children = make([]Child, zb0002)
+			}
+			for za0001 := range z.Children {
+				var zb0003 uint32
+				zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Children", za0001)
+					return
+				}
+				for zb0003 > 0 {
+					zb0003--
+					field, bts, err = msgp.ReadMapKeyZC(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Children", za0001)
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "Name":
+						z.Children[za0001].Name, bts, err = msgp.ReadStringBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Children", za0001, "Name")
+							return
+						}
+					case "BirthDay":
+						z.Children[za0001].BirthDay, bts, err = msgp.ReadTimeBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Children", za0001, "BirthDay")
+							return
+						}
+					case "Grade":
+						z.Children[za0001].Grade, bts, err = msgp.ReadIntBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Children", za0001, "Grade")
+							return
+						}
+					default:
+						bts, err = msgp.Skip(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Children", za0001)
+							return
+						}
+					}
+				}
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Person) Msgsize() (s int) {
+	s = 1 + 5 + msgp.StringPrefixSize + len(z.Name) + 9 + msgp.TimeSize + 6 + msgp.StringPrefixSize + len(z.Phone) + 6 + msgp.Float64Size + 8 + z.Address.Msgsize() + 9 + msgp.ArrayHeaderSize
+	for za0001 := range z.Children {
+		s += 1 + 5 + msgp.StringPrefixSize + len(z.Children[za0001].Name) + 9 + msgp.TimeSize + 6 + msgp.IntSize
+	}
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *Purchase) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Item":
+			z.Item, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Item")
+				return
+			}
+		case "Quantity":
+			z.Quantity, err = dc.ReadInt()
+			if err != nil {
+				err = msgp.WrapError(err, "Quantity")
+				return
+			}
+		case "Price":
+			z.Price, err = dc.ReadFloat64()
+			if err != nil {
+				err = msgp.WrapError(err, "Price")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z Purchase) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 3
+	// write "Item"
+	err = en.Append(0x83, 0xa4, 0x49, 0x74, 0x65, 0x6d)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Item)
+	if err != nil {
+		err = msgp.WrapError(err, "Item")
+		return
+	}
+	// write "Quantity"
+	err = en.Append(0xa8, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(z.Quantity)
+	if err != nil {
+		err = msgp.WrapError(err, "Quantity")
+		return
+	}
+	// write "Price"
+	err = en.Append(0xa5, 0x50, 0x72, 0x69, 0x63, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteFloat64(z.Price)
+	if err != nil {
+		err = msgp.WrapError(err, "Price")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z Purchase) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 3
+	// string "Item"
+	o = append(o, 0x83, 0xa4, 0x49, 0x74, 0x65, 0x6d)
+	o = msgp.AppendString(o, z.Item)
+	// string "Quantity"
+	o = append(o, 0xa8, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79)
+	o = msgp.AppendInt(o, z.Quantity)
+	// string "Price"
+	o = append(o, 0xa5, 0x50, 0x72, 0x69, 0x63, 0x65)
+	o = msgp.AppendFloat64(o, z.Price)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Purchase) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Item":
+			z.Item, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Item")
+				return
+			}
+		case "Quantity":
+			z.Quantity, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Quantity")
+				return
+			}
+		case "Price":
+			z.Price, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Price")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z Purchase) Msgsize() (s int) {
+	s = 1 + 5 + msgp.StringPrefixSize + len(z.Item) + 9 + msgp.IntSize + 6 + msgp.Float64Size
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *Series) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Name":
+			z.Name, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Name")
+				return
+			}
+		case "Timestamps":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Timestamps")
+				return
+			}
+			if cap(z.Timestamps) >= int(zb0002) {
+				z.Timestamps = (z.Timestamps)[:zb0002]
+			} else {
+				z.Timestamps = make([]int64, zb0002)
+			}
+			for za0001 := range z.Timestamps {
+				z.Timestamps[za0001], err = dc.ReadInt64()
+				if err != nil {
+					err = msgp.WrapError(err, "Timestamps", za0001)
+					return
+				}
+			}
+		case "Values":
+			var zb0003 uint32
+			zb0003, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Values")
+				return
+			}
+			if cap(z.Values) >= int(zb0003) {
+				z.Values = (z.Values)[:zb0003]
+			} else {
+				z.Values = make([]float64, zb0003)
+			}
+			for za0002 := range z.Values {
+				z.Values[za0002], err = dc.ReadFloat64()
+				if err != nil {
+					err = msgp.WrapError(err, "Values", za0002)
+					return
+				}
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *Series) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 3
+	// write "Name"
+	err = en.Append(0x83, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Name)
+	if err != nil {
+		err = msgp.WrapError(err, "Name")
+		return
+	}
+	// write "Timestamps"
+	err = en.Append(0xaa, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.Timestamps)))
+	if err != nil {
+		err = msgp.WrapError(err, "Timestamps")
+		return
+	}
+	for za0001 := range z.Timestamps {
+		err = en.WriteInt64(z.Timestamps[za0001])
+		if err != nil {
+			err = msgp.WrapError(err, "Timestamps", za0001)
+			return
+		}
+	}
+	// write "Values"
+	err = en.Append(0xa6, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.Values)))
+	if err != nil {
+		err = msgp.WrapError(err, "Values")
+		return
+	}
+	for za0002 := range z.Values {
+		err = en.WriteFloat64(z.Values[za0002])
+		if err != nil {
+			err = msgp.WrapError(err, "Values", za0002)
+			return
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Series) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 3
+	// string "Name"
+	o = append(o, 0x83, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	o = msgp.AppendString(o, z.Name)
+	// string "Timestamps"
+	o = append(o, 0xaa, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Timestamps)))
+	for za0001 := range z.Timestamps {
+		o = msgp.AppendInt64(o, z.Timestamps[za0001])
+	}
+	// string "Values"
+	o = append(o, 0xa6, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Values)))
+	for za0002 := range z.Values {
+		o = msgp.AppendFloat64(o, z.Values[za0002])
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Series) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Name":
+			z.Name, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Name")
+				return
+			}
+		case "Timestamps":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Timestamps")
+				return
+			}
+			if cap(z.Timestamps) >= int(zb0002) {
+				z.Timestamps = (z.Timestamps)[:zb0002]
+			} else {
+				z.Timestamps = make([]int64, zb0002)
+			}
+			for za0001 := range z.Timestamps {
+				z.Timestamps[za0001], bts, err = msgp.ReadInt64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Timestamps", za0001)
+					return
+				}
+			}
+		case "Values":
+			var zb0003 uint32
+			zb0003, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Values")
+				return
+			}
+			if cap(z.Values) >= int(zb0003) {
+				z.Values = (z.Values)[:zb0003]
+			} else {
+				z.Values = make([]float64, zb0003)
+			}
+			for za0002 := range z.Values {
+				z.Values[za0002], bts, err = msgp.ReadFloat64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Values", za0002)
+					return
+				}
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Series) Msgsize() (s int) {
+	s = 1 + 5 + msgp.StringPrefixSize + len(z.Name) + 11 + msgp.ArrayHeaderSize + (len(z.Timestamps) * (msgp.Int64Size)) + 7 + msgp.ArrayHeaderSize + (len(z.Values) * (msgp.Float64Size))
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *Signup) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Name":
+			z.Name, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Name")
+				return
+			}
+		case "Email":
+			z.Email, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Email")
+				return
+			}
+		case "Referrer":
+			z.Referrer, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Referrer")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z Signup) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 3
+	// write "Name"
+	err = en.Append(0x83, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Name)
+	if err != nil {
+		err = msgp.WrapError(err, "Name")
+		return
+	}
+	// write "Email"
+	err = en.Append(0xa5, 0x45, 0x6d, 0x61, 0x69, 0x6c)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Email)
+	if err != nil {
+		err = msgp.WrapError(err, "Email")
+		return
+	}
+	// write "Referrer"
+	err = en.Append(0xa8, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Referrer)
+	if err != nil {
+		err = msgp.WrapError(err, "Referrer")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z Signup) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 3
+	// string "Name"
+	o = append(o, 0x83, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	o = msgp.AppendString(o, z.Name)
+	// string "Email"
+	o = append(o, 0xa5, 0x45, 0x6d, 0x61, 0x69, 0x6c)
+	o = msgp.AppendString(o, z.Email)
+	// string "Referrer"
+	o = append(o, 0xa8, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72)
+	o = msgp.AppendString(o, z.Referrer)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Signup) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Name":
+			z.Name, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Name")
+				return
+			}
+		case "Email":
+			z.Email, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Email")
+				return
+			}
+		case "Referrer":
+			z.Referrer, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Referrer")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z Signup) Msgsize() (s int) {
+	s = 1 + 5 + msgp.StringPrefixSize + len(z.Name) + 6 + msgp.StringPrefixSize + len(z.Email) + 9 + msgp.StringPrefixSize + len(z.Referrer)
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *TaggedA) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Name":
+			z.Name, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Name")
+				return
+			}
+		case "BirthDay":
+			z.BirthDay, err = dc.ReadTime()
 			if err != nil {
 				err = msgp.WrapError(err, "BirthDay")
 				return
@@ -288,6 +3217,55 @@ func (z *NoTimeA) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "Money")
 				return
 			}
+		case "Tags":
+			var zb0002 uint32
+			zb0002, err = dc.ReadMapHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Tags")
+				return
+			}
+			if z.Tags == nil {
+				z.Tags = make(map[string]string, zb0002)
+			} else if len(z.Tags) > 0 {
+				for key := range z.Tags {
+					delete(z.Tags, key)
+				}
+			}
+			for zb0002 > 0 {
+				zb0002--
+				var za0001 string
+				var za0002 string
+				za0001, err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "Tags")
+					return
+				}
+				za0002, err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "Tags", za0001)
+					return
+				}
+				z.Tags[za0001] = za0002
+			}
+		case "Aliases":
+			var zb0003 uint32
+			zb0003, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Aliases")
+				return
+			}
+			if cap(z.Aliases) >= int(zb0003) {
+				z.Aliases = (z.Aliases)[:zb0003]
+			} else {
+				z.Aliases = make([]string, zb0003)
+			}
+			for za0003 := range z.Aliases {
+				z.Aliases[za0003], err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "Aliases", za0003)
+					return
+				}
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -300,10 +3278,10 @@ func (z *NoTimeA) DecodeMsg(dc *msgp.Reader) (err error) {
 }
 
 // EncodeMsg implements msgp.Encodable
-func (z *NoTimeA) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 6
+func (z *TaggedA) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 8
 	// write "Name"
-	err = en.Append(0x86, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	err = en.Append(0x88, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
 	if err != nil {
 		return
 	}
@@ -317,7 +3295,7 @@ func (z *NoTimeA) EncodeMsg(en *msgp.Writer) (err error) {
 	if err != nil {
 		return
 	}
-	err = en.WriteInt64(z.BirthDay)
+	err = en.WriteTime(z.BirthDay)
 	if err != nil {
 		err = msgp.WrapError(err, "BirthDay")
 		return
@@ -362,19 +3340,58 @@ func (z *NoTimeA) EncodeMsg(en *msgp.Writer) (err error) {
 		err = msgp.WrapError(err, "Money")
 		return
 	}
+	// write "Tags"
+	err = en.Append(0xa4, 0x54, 0x61, 0x67, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteMapHeader(uint32(len(z.Tags)))
+	if err != nil {
+		err = msgp.WrapError(err, "Tags")
+		return
+	}
+	for za0001, za0002 := range z.Tags {
+		err = en.WriteString(za0001)
+		if err != nil {
+			err = msgp.WrapError(err, "Tags")
+			return
+		}
+		err = en.WriteString(za0002)
+		if err != nil {
+			err = msgp.WrapError(err, "Tags", za0001)
+			return
+		}
+	}
+	// write "Aliases"
+	err = en.Append(0xa7, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.Aliases)))
+	if err != nil {
+		err = msgp.WrapError(err, "Aliases")
+		return
+	}
+	for za0003 := range z.Aliases {
+		err = en.WriteString(z.Aliases[za0003])
+		if err != nil {
+			err = msgp.WrapError(err, "Aliases", za0003)
+			return
+		}
+	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
-func (z *NoTimeA) MarshalMsg(b []byte) (o []byte, err error) {
+func (z *TaggedA) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 6
+	// map header, size 8
 	// string "Name"
-	o = append(o, 0x86, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	o = append(o, 0x88, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
 	o = msgp.AppendString(o, z.Name)
 	// string "BirthDay"
 	o = append(o, 0xa8, 0x42, 0x69, 0x72, 0x74, 0x68, 0x44, 0x61, 0x79)
-	o = msgp.AppendInt64(o, z.BirthDay)
+	o = msgp.AppendTime(o, z.BirthDay)
 	// string "Phone"
 	o = append(o, 0xa5, 0x50, 0x68, 0x6f, 0x6e, 0x65)
 	o = msgp.AppendString(o, z.Phone)
@@ -387,11 +3404,24 @@ func (z *NoTimeA) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "Money"
 	o = append(o, 0xa5, 0x4d, 0x6f, 0x6e, 0x65, 0x79)
 	o = msgp.AppendFloat64(o, z.Money)
+	// string "Tags"
+	o = append(o, 0xa4, 0x54, 0x61, 0x67, 0x73)
+	o = msgp.AppendMapHeader(o, uint32(len(z.Tags)))
+	for za0001, za0002 := range z.Tags {
+		o = msgp.AppendString(o, za0001)
+		o = msgp.AppendString(o, za0002)
+	}
+	// string "Aliases"
+	o = append(o, 0xa7, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Aliases)))
+	for za0003 := range z.Aliases {
+		o = msgp.AppendString(o, z.Aliases[za0003])
+	}
 	return
 }
 
 // UnmarshalMsg implements msgp.Unmarshaler
-func (z *NoTimeA) UnmarshalMsg(bts []byte) (o []byte, err error) {
+func (z *TaggedA) UnmarshalMsg(bts []byte) (o []byte, err error) {
 	var field []byte
 	_ = field
 	var zb0001 uint32
@@ -415,7 +3445,7 @@ func (z *NoTimeA) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				return
 			}
 		case "BirthDay":
-			z.BirthDay, bts, err = msgp.ReadInt64Bytes(bts)
+			z.BirthDay, bts, err = msgp.ReadTimeBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "BirthDay")
 				return
@@ -444,6 +3474,55 @@ func (z *NoTimeA) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "Money")
 				return
 			}
+		case "Tags":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Tags")
+				return
+			}
+			if z.Tags == nil {
+				z.Tags = make(map[string]string, zb0002)
+			} else if len(z.Tags) > 0 {
+				for key := range z.Tags {
+					delete(z.Tags, key)
+				}
+			}
+			for zb0002 > 0 {
+				var za0001 string
+				var za0002 string
+				zb0002--
+				za0001, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Tags")
+					return
+				}
+				za0002, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Tags", za0001)
+					return
+				}
+				z.Tags[za0001] = za0002
+			}
+		case "Aliases":
+			var zb0003 uint32
+			zb0003, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Aliases")
+				return
+			}
+			if cap(z.Aliases) >= int(zb0003) {
+				z.Aliases = (z.Aliases)[:zb0003]
+			} else {
+				z.Aliases = make([]string, zb0003)
+			}
+			for za0003 := range z.Aliases {
+				z.Aliases[za0003], bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Aliases", za0003)
+					return
+				}
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -457,7 +3536,17 @@ func (z *NoTimeA) UnmarshalMsg(bts []byte) (o []byte, err error) {
 }
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
-func (z *NoTimeA) Msgsize() (s int) {
-	s = 1 + 5 + msgp.StringPrefixSize + len(z.Name) + 9 + msgp.Int64Size + 6 + msgp.StringPrefixSize + len(z.Phone) + 9 + msgp.IntSize + 7 + msgp.BoolSize + 6 + msgp.Float64Size
+func (z *TaggedA) Msgsize() (s int) {
+	s = 1 + 5 + msgp.StringPrefixSize + len(z.Name) + 9 + msgp.TimeSize + 6 + msgp.StringPrefixSize + len(z.Phone) + 9 + msgp.IntSize + 7 + msgp.BoolSize + 6 + msgp.Float64Size + 5 + msgp.MapHeaderSize
+	if z.Tags != nil {
+		for za0001, za0002 := range z.Tags {
+			_ = za0002
+			s += msgp.StringPrefixSize + len(za0001) + msgp.StringPrefixSize + len(za0002)
+		}
+	}
+	s += 8 + msgp.ArrayHeaderSize
+	for za0003 := range z.Aliases {
+		s += msgp.StringPrefixSize + len(z.Aliases[za0003])
+	}
 	return
 }
@@ -0,0 +1,501 @@
+package goserbench
+
+import (
+	"math"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/google/flatbuffers/go"
+)
+
+// maxChildren bounds the number of children of a generated Person.
+const maxChildren = 4
+
+// generateNested returns Person records with an embedded Address and zero to
+// maxChildren children, exercising nested messages and repeated fields.
+func generateNested() []*Person {
+	r := newRand()
+	p := make([]*Person, 0, corpusSize)
+	for i := 0; i < corpusSize; i++ {
+		var children []Child
+		for j := r.Intn(maxChildren + 1); j > 0; j-- {
+			children = append(children, Child{
+				Name:     randString(r, 16),
+				BirthDay: randTime(r),
+				Grade:    r.Intn(13),
+			})
+		}
+		p = append(p, &Person{
+			Name:     randString(r, 16),
+			BirthDay: randTime(r),
+			Phone:    randString(r, 10),
+			Money:    r.Float64(),
+			Address: Address{
+				Street:  randString(r, 24),
+				City:    randString(r, 12),
+				Zip:     randString(r, 5),
+				Country: randString(r, 2),
+			},
+			Children: children,
+		})
+	}
+	return p
+}
+
+var payloadNested = &Payload{
+	Name:     "Nested",
+	Generate: func() []interface{} { return interfaces(generateNested()) },
+	New:      func() interface{} { return &Person{} },
+}
+
+// github.com/google/flatbuffers/go
+
+type FlatBufferPersonSerializer struct {
+	builder *flatbuffers.Builder
+}
+
+func (s *FlatBufferPersonSerializer) Marshal(o interface{}) ([]byte, error) {
+	p := o.(*Person)
+	builder := s.builder
+
+	builder.Reset()
+
+	children := make([]flatbuffers.UOffsetT, len(p.Children))
+	for i, c := range p.Children {
+		name := builder.CreateString(c.Name)
+		FlatBufferChildStart(builder)
+		FlatBufferChildAddName(builder, name)
+		FlatBufferChildAddBirthDay(builder, c.BirthDay.UnixNano())
+		FlatBufferChildAddGrade(builder, int32(c.Grade))
+		children[i] = FlatBufferChildEnd(builder)
+	}
+	FlatBufferPersonStartChildrenVector(builder, len(children))
+	for i := len(children) - 1; i >= 0; i-- {
+		builder.PrependUOffsetT(children[i])
+	}
+	childrenVector := builder.EndVector(len(children))
+
+	street := builder.CreateString(p.Address.Street)
+	city := builder.CreateString(p.Address.City)
+	zip := builder.CreateString(p.Address.Zip)
+	country := builder.CreateString(p.Address.Country)
+	FlatBufferAddressStart(builder)
+	FlatBufferAddressAddStreet(builder, street)
+	FlatBufferAddressAddCity(builder, city)
+	FlatBufferAddressAddZip(builder, zip)
+	FlatBufferAddressAddCountry(builder, country)
+	address := FlatBufferAddressEnd(builder)
+
+	name := builder.CreateString(p.Name)
+	phone := builder.CreateString(p.Phone)
+
+	FlatBufferPersonStart(builder)
+	FlatBufferPersonAddName(builder, name)
+	FlatBufferPersonAddBirthDay(builder, p.BirthDay.UnixNano())
+	FlatBufferPersonAddPhone(builder, phone)
+	FlatBufferPersonAddMoney(builder, p.Money)
+	FlatBufferPersonAddAddress(builder, address)
+	FlatBufferPersonAddChildren(builder, childrenVector)
+	builder.Finish(FlatBufferPersonEnd(builder))
+	return builder.Bytes[builder.Head():], nil
+}
+
+func (s *FlatBufferPersonSerializer) Unmarshal(d []byte, i interface{}) error {
+	p := i.(*Person)
+	o := FlatBufferPerson{}
+	o.Init(d, flatbuffers.GetUOffsetT(d))
+	p.Name = string(o.Name())
+	p.BirthDay = time.Unix(0, o.BirthDay())
+	p.Phone = string(o.Phone())
+	p.Money = o.Money()
+	var a FlatBufferAddress
+	if o.Address(&a) != nil {
+		p.Address = Address{
+			Street:  string(a.Street()),
+			City:    string(a.City()),
+			Zip:     string(a.Zip()),
+			Country: string(a.Country()),
+		}
+	}
+	p.Children = nil
+	if n := o.ChildrenLength(); n > 0 {
+		p.Children = make([]Child, n)
+		var c FlatBufferChild
+		for j := range p.Children {
+			o.Children(&c, j)
+			p.Children[j] = Child{
+				Name:     string(c.Name()),
+				BirthDay: time.Unix(0, c.BirthDay()),
+				Grade:    int(c.Grade()),
+			}
+		}
+	}
+	return nil
+}
+
+func (s *FlatBufferPersonSerializer) String() string {
+	return "FlatBuffer"
+}
+
+// github.com/golang/protobuf
+
+var protoBufPersonConverter = &Converter{
+	New: func() interface{} { return &ProtoBufPerson{} },
+	From: func(dst, src interface{}) {
+		p := src.(*Person)
+		children := make([]*ProtoBufChild, len(p.Children))
+		for i, c := range p.Children {
+			children[i] = &ProtoBufChild{
+				Name:     proto.String(c.Name),
+				BirthDay: proto.Int64(c.BirthDay.UnixNano()),
+				Grade:    proto.Int32(int32(c.Grade)),
+			}
+		}
+		*dst.(*ProtoBufPerson) = ProtoBufPerson{
+			Name:     proto.String(p.Name),
+			BirthDay: proto.Int64(p.BirthDay.UnixNano()),
+			Phone:    proto.String(p.Phone),
+			Money:    proto.Float64(p.Money),
+			Address: &ProtoBufAddress{
+				Street:  proto.String(p.Address.Street),
+				City:    proto.String(p.Address.City),
+				Zip:     proto.String(p.Address.Zip),
+				Country: proto.String(p.Address.Country),
+			},
+			Children: children,
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*ProtoBufPerson)
+		var children []Child
+		for _, c := range o.GetChildren() {
+			children = append(children, Child{
+				Name:     c.GetName(),
+				BirthDay: time.Unix(0, c.GetBirthDay()),
+				Grade:    int(c.GetGrade()),
+			})
+		}
+		a := o.GetAddress()
+		*dst.(*Person) = Person{
+			Name:     o.GetName(),
+			BirthDay: time.Unix(0, o.GetBirthDay()),
+			Phone:    o.GetPhone(),
+			Money:    o.GetMoney(),
+			Address: Address{
+				Street:  a.GetStreet(),
+				City:    a.GetCity(),
+				Zip:     a.GetZip(),
+				Country: a.GetCountry(),
+			},
+			Children: children,
+		}
+	},
+}
+
+// github.com/gogo/protobuf/proto
+
+var gogoProtoBufPersonConverter = &Converter{
+	New: func() interface{} { return &GogoProtoBufPerson{} },
+	From: func(dst, src interface{}) {
+		p := src.(*Person)
+		var children []GogoProtoBufChild
+		for _, c := range p.Children {
+			children = append(children, GogoProtoBufChild{
+				Name:     c.Name,
+				BirthDay: c.BirthDay.UnixNano(),
+				Grade:    int32(c.Grade),
+			})
+		}
+		*dst.(*GogoProtoBufPerson) = GogoProtoBufPerson{
+			Name:     p.Name,
+			BirthDay: p.BirthDay.UnixNano(),
+			Phone:    p.Phone,
+			Money:    p.Money,
+			Address:  GogoProtoBufAddress(p.Address),
+			Children: children,
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*GogoProtoBufPerson)
+		var children []Child
+		for _, c := range o.Children {
+			children = append(children, Child{
+				Name:     c.Name,
+				BirthDay: time.Unix(0, c.BirthDay),
+				Grade:    int(c.Grade),
+			})
+		}
+		*dst.(*Person) = Person{
+			Name:     o.Name,
+			BirthDay: time.Unix(0, o.BirthDay),
+			Phone:    o.Phone,
+			Money:    o.Money,
+			Address:  Address(o.Address),
+			Children: children,
+		}
+	},
+}
+
+// github.com/pascaldekloe/colfer
+
+var colferPersonConverter = &Converter{
+	New: func() interface{} { return &ColferPerson{} },
+	From: func(dst, src interface{}) {
+		p := src.(*Person)
+		var children []*ColferChild
+		for _, c := range p.Children {
+			children = append(children, &ColferChild{
+				Name:     c.Name,
+				BirthDay: c.BirthDay,
+				Grade:    int32(c.Grade),
+			})
+		}
+		a := ColferAddress(p.Address)
+		*dst.(*ColferPerson) = ColferPerson{
+			Name:     p.Name,
+			BirthDay: p.BirthDay,
+			Phone:    p.Phone,
+			Money:    p.Money,
+			Address:  &a,
+			Children: children,
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*ColferPerson)
+		var children []Child
+		for _, c := range o.Children {
+			children = append(children, Child{
+				Name:     c.Name,
+				BirthDay: c.BirthDay,
+				Grade:    int(c.Grade),
+			})
+		}
+		var a Address
+		if o.Address != nil {
+			a = Address(*o.Address)
+		}
+		*dst.(*Person) = Person{
+			Name:     o.Name,
+			BirthDay: o.BirthDay,
+			Phone:    o.Phone,
+			Money:    o.Money,
+			Address:  a,
+			Children: children,
+		}
+	},
+}
+
+// github.com/andyleap/gencode
+
+var gencodePersonConverter = &Converter{
+	New: func() interface{} { return &GencodePerson{} },
+	From: func(dst, src interface{}) {
+		p := src.(*Person)
+		var children []GencodeChild
+		for _, c := range p.Children {
+			children = append(children, GencodeChild{
+				Name:     c.Name,
+				BirthDay: c.BirthDay,
+				Grade:    int64(c.Grade),
+			})
+		}
+		*dst.(*GencodePerson) = GencodePerson{
+			Name:     p.Name,
+			BirthDay: p.BirthDay,
+			Phone:    p.Phone,
+			Money:    p.Money,
+			Address:  GencodeAddress(p.Address),
+			Children: children,
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*GencodePerson)
+		var children []Child
+		for _, c := range o.Children {
+			children = append(children, Child{
+				Name:     c.Name,
+				BirthDay: c.BirthDay,
+				Grade:    int(c.Grade),
+			})
+		}
+		*dst.(*Person) = Person{
+			Name:     o.Name,
+			BirthDay: o.BirthDay,
+			Phone:    o.Phone,
+			Money:    o.Money,
+			Address:  Address(o.Address),
+			Children: children,
+		}
+	},
+}
+
+var gencodeUnsafePersonConverter = &Converter{
+	New: func() interface{} { return &GencodeUnsafePerson{} },
+	From: func(dst, src interface{}) {
+		p := src.(*Person)
+		var children []GencodeUnsafeChild
+		for _, c := range p.Children {
+			children = append(children, GencodeUnsafeChild{
+				Name:     c.Name,
+				BirthDay: c.BirthDay.UnixNano(),
+				Grade:    int64(c.Grade),
+			})
+		}
+		*dst.(*GencodeUnsafePerson) = GencodeUnsafePerson{
+			Name:     p.Name,
+			BirthDay: p.BirthDay.UnixNano(),
+			Phone:    p.Phone,
+			Money:    p.Money,
+			Address:  GencodeUnsafeAddress(p.Address),
+			Children: children,
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*GencodeUnsafePerson)
+		var children []Child
+		for _, c := range o.Children {
+			children = append(children, Child{
+				Name:     c.Name,
+				BirthDay: time.Unix(0, c.BirthDay),
+				Grade:    int(c.Grade),
+			})
+		}
+		*dst.(*Person) = Person{
+			Name:     o.Name,
+			BirthDay: time.Unix(0, o.BirthDay),
+			Phone:    o.Phone,
+			Money:    o.Money,
+			Address:  Address(o.Address),
+			Children: children,
+		}
+	},
+}
+
+// github.com/calmh/xdr
+
+var xdrPersonConverter = &Converter{
+	New: func() interface{} { return &XDRPerson{} },
+	From: func(dst, src interface{}) {
+		p := src.(*Person)
+		var children []XDRChild
+		for _, c := range p.Children {
+			children = append(children, XDRChild{
+				Name:     c.Name,
+				BirthDay: c.BirthDay.UnixNano(),
+				Grade:    int32(c.Grade),
+			})
+		}
+		*dst.(*XDRPerson) = XDRPerson{
+			Name:     p.Name,
+			BirthDay: p.BirthDay.UnixNano(),
+			Phone:    p.Phone,
+			Money:    math.Float64bits(p.Money),
+			Address:  XDRAddress(p.Address),
+			Children: children,
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*XDRPerson)
+		var children []Child
+		for _, c := range o.Children {
+			children = append(children, Child{
+				Name:     c.Name,
+				BirthDay: time.Unix(0, c.BirthDay),
+				Grade:    int(c.Grade),
+			})
+		}
+		*dst.(*Person) = Person{
+			Name:     o.Name,
+			BirthDay: time.Unix(0, o.BirthDay),
+			Phone:    o.Phone,
+			Money:    math.Float64frombits(o.Money),
+			Address:  Address(o.Address),
+			Children: children,
+		}
+	},
+}
+
+// github.com/ikkerens/ikeapack
+
+type IkePerson struct {
+	Name     string
+	BirthDay int64
+	Phone    string
+	Money    uint64
+	Address  IkeAddress
+	Children []IkeChild
+}
+
+type IkeAddress struct {
+	Street  string
+	City    string
+	Zip     string
+	Country string
+}
+
+type IkeChild struct {
+	Name     string
+	BirthDay int64
+	Grade    int32
+}
+
+var ikePersonConverter = &Converter{
+	New: func() interface{} { return &IkePerson{} },
+	From: func(dst, src interface{}) {
+		p := src.(*Person)
+		var children []IkeChild
+		for _, c := range p.Children {
+			children = append(children, IkeChild{
+				Name:     c.Name,
+				BirthDay: c.BirthDay.UnixNano(),
+				Grade:    int32(c.Grade),
+			})
+		}
+		*dst.(*IkePerson) = IkePerson{
+			Name:     p.Name,
+			BirthDay: p.BirthDay.UnixNano(),
+			Phone:    p.Phone,
+			Money:    math.Float64bits(p.Money),
+			Address:  IkeAddress(p.Address),
+			Children: children,
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*IkePerson)
+		var children []Child
+		for _, c := range o.Children {
+			children = append(children, Child{
+				Name:     c.Name,
+				BirthDay: time.Unix(0, c.BirthDay),
+				Grade:    int(c.Grade),
+			})
+		}
+		*dst.(*Person) = Person{
+			Name:     o.Name,
+			BirthDay: time.Unix(0, o.BirthDay),
+			Phone:    o.Phone,
+			Money:    math.Float64frombits(o.Money),
+			Address:  Address(o.Address),
+			Children: children,
+		}
+	},
+}
+
+// The Nested payload is registered for every serializer of A, under the same
+// names.
+func init() {
+	RegisterPayload(payloadNested, map[string]Adaptation{
+		"Gotiny": {New: func() Serializer { return NewGotinySerializer(Person{}) }},
+		"Gob":    {New: func() Serializer { return NewGobSerializer(Person{}) }},
+		"FlatBuffers": {Converter: flatBufferConverter(func() Serializer {
+			return &FlatBufferPersonSerializer{flatbuffers.NewBuilder(0)}
+		})},
+		"Goprotobuf":    {Converter: protoBufPersonConverter},
+		"Gogoprotobuf":  {Converter: gogoProtoBufPersonConverter},
+		"Colfer":        {Converter: colferPersonConverter},
+		"Gencode":       {Converter: gencodePersonConverter},
+		"GencodeUnsafe": {Converter: gencodeUnsafePersonConverter},
+		"XDR2":          {Converter: xdrPersonConverter},
+		"Ikea":          {Converter: ikePersonConverter},
+	})
+}
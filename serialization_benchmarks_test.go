@@ -2,19 +2,28 @@ package goserbench
 
 import (
 	"bytes"
+	"encoding/binary"
 	"encoding/gob"
 	"encoding/json"
+	"flag"
 	"fmt"
+	"io"
 	"math"
 	"math/rand"
 	"os"
 	"reflect"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/niubaoshu/gotiny"
 
 	"github.com/DeDiS/protobuf"
+	"github.com/calmh/xdr"
 	"github.com/gogo/protobuf/proto"
 	"github.com/google/flatbuffers/go"
 	"github.com/ikkerens/ikeapack"
@@ -29,45 +38,598 @@ import (
 var (
 	validate     = os.Getenv("VALIDATE")
 	jsoniterFast = jsoniter.ConfigFastest
+
+	seed = flag.Int64("seed", envInt("SEED", 1), "seed the corpus is generated from (default $SEED or 1)")
+	// clock is the fixed point in time generated timestamps are relative
+	// to, so that the corpus does not depend on when the benchmarks run.
+	clock = time.Date(2019, 2, 27, 12, 0, 0, 0, time.UTC)
 )
 
-func randString(l int) string {
+const corpusSize = 1000
+
+// maxCorpusBytes bounds the data of a corpus whose records grow with a
+// parameter, which then holds fewer of them.
+const maxCorpusBytes = 16 << 20
+
+// corpusLen returns how many records of about size bytes a corpus holds.
+func corpusLen(size int) int {
+	n := maxCorpusBytes / size
+	if n > corpusSize {
+		return corpusSize
+	}
+	if n < 1 {
+		return 1
+	}
+	return n
+}
+
+// envInt returns the integer in the environment variable key, or def.
+func envInt(key string, def int64) int64 {
+	if s, err := strconv.ParseInt(os.Getenv(key), 10, 64); err == nil {
+		return s
+	}
+	return def
+}
+
+// envFloat returns the number in the environment variable key, or def.
+func envFloat(key string, def float64) float64 {
+	if f, err := strconv.ParseFloat(os.Getenv(key), 64); err == nil {
+		return f
+	}
+	return def
+}
+
+// newRand returns a random source derived from the corpus seed. Every
+// generator uses its own, so payloads do not depend on each other or on the
+// order they are generated in.
+func newRand() *rand.Rand {
+	return rand.New(rand.NewSource(*seed))
+}
+
+func randString(r *rand.Rand, l int) string {
 	buf := make([]byte, l)
 	for i := 0; i < (l+1)/2; i++ {
-		buf[i] = byte(rand.Intn(256))
+		buf[i] = byte(r.Intn(256))
 	}
 	return fmt.Sprintf("%x", buf)[:l]
 }
 
+// randTime returns a time up to 80 years before clock, with nanoseconds.
+func randTime(r *rand.Rand) time.Time {
+	return clock.Add(-time.Duration(r.Int63n(int64(80 * 365 * 24 * time.Hour))))
+}
+
 func generate() []*A {
-	a := make([]*A, 0, 1000)
-	for i := 0; i < 1000; i++ {
+	r := newRand()
+	a := make([]*A, 0, corpusSize)
+	for i := 0; i < corpusSize; i++ {
 		a = append(a, &A{
-			Name:     randString(16),
-			BirthDay: time.Now(),
-			Phone:    randString(10),
-			Siblings: rand.Intn(5),
-			Spouse:   rand.Intn(2) == 1,
-			Money:    rand.Float64(),
+			Name:     randString(r, 16),
+			BirthDay: randTime(r),
+			Phone:    randString(r, 10),
+			Siblings: r.Intn(5),
+			Spouse:   r.Intn(2) == 1,
+			Money:    r.Float64(),
 		})
 	}
 	return a
 }
 
+// Serializer is the interface every benchmarked codec is adapted to. Marshal
+// must report encoder failures rather than return partial output.
 type Serializer interface {
-	Marshal(o interface{}) []byte
+	Marshal(o interface{}) ([]byte, error)
 	Unmarshal(d []byte, o interface{}) error
 	String() string
 }
 
-func benchMarshal(b *testing.B, s Serializer) {
+// MarshalToSerializer is implemented by serializers whose codec can encode
+// into a caller supplied buffer instead of allocating a new one. MarshalTo
+// reuses the capacity of buf, ignoring its length, and returns the encoded
+// bytes, which may alias buf.
+type MarshalToSerializer interface {
+	MarshalTo(buf []byte, o interface{}) ([]byte, error)
+}
+
+// grow returns buf resliced to n bytes, allocating only if its capacity is
+// too small.
+func grow(buf []byte, n int) []byte {
+	if cap(buf) < n {
+		return make([]byte, n)
+	}
+	return buf[:n]
+}
+
+// Payload describes a corpus of records that serializers are benchmarked
+// against: how to generate it and how to allocate a decode target. Decoded
+// records are checked against the originals with Diff.
+type Payload struct {
+	Name     string
+	Generate func() []interface{}
+	New      func() interface{}
+	// Unordered is set when records contain maps, whose iteration order
+	// makes encodings of the same record differ from run to run.
+	Unordered bool
+	// Binary is set when records carry byte slices. Unmarshal then reports
+	// whether the decoded ones alias the encoded message.
+	Binary bool
+	// PerMessage is the number of records of A each record of the payload
+	// holds. The benchmarks then also report their cost per record of A.
+	PerMessage int
+	// Sparse is set when records may have no field set at all, which codecs
+	// leaving out empty fields encode as no bytes.
+	Sparse bool
+
+	once    sync.Once
+	records []interface{}
+}
+
+// Records returns the corpus of the payload. It is generated once per run,
+// so every serializer encodes exactly the same records.
+func (p *Payload) Records() []interface{} {
+	p.once.Do(func() { p.records = p.Generate() })
+	return p.records
+}
+
+// SerializerInfo is the registry entry of a serializer.
+type SerializerInfo struct {
+	// Name is used as the sub-benchmark name, e.g. BenchmarkSerializers/Msgp.
+	Name string
+	// Package is the import path of the library under test.
+	Package string
+	// Generated is set when the codec relies on generated code.
+	Generated bool
+	// New returns a fresh serializer instance.
+	New func() Serializer
+	// GoroutineSafe is set when a single instance may be used from several
+	// goroutines at once, i.e. the serializer holds no mutable state.
+	GoroutineSafe bool
+	// Payload is the corpus to benchmark against; nil means payloadA.
+	Payload *Payload
+	// Converter is set for serializers working on a generated type instead
+	// of A. Records are converted before timing starts so that Marshal and
+	// Unmarshal measure pure encode/decode time, while the conversion itself
+	// is benchmarked separately.
+	Converter *Converter
+	// Tolerance relaxes validation for codecs that lose precision.
+	Tolerance Tolerance
+	// OnlyA is why a serializer of A cannot run against payloads of other
+	// types, if it cannot. RegisterPayload skips it for them.
+	OnlyA string
+
+	// baseline marks the no-op serializer calibrating the harness, which is
+	// never validated and reports no net time.
+	baseline bool
+	// skip is why the serializer of A was not adapted to the payload. The
+	// benchmarks and tests of a skipped serializer skip with it as message.
+	skip string
+}
+
+// Converter maps the records of a payload to and from the generated type of a
+// schema-bound codec.
+type Converter struct {
+	// New allocates a zero value of the generated type.
+	New func() interface{}
+	// From fills the generated value dst from the payload record src.
+	From func(dst, src interface{})
+	// To fills the payload record dst from the generated value src.
+	To func(dst, src interface{})
+}
+
+func (info SerializerInfo) payload() *Payload {
+	if info.Payload == nil {
+		return payloadA
+	}
+	return info.Payload
+}
+
+// corpus generates the payload records and the values handed to the
+// serializer, which only differ for serializers with a Converter.
+func (info SerializerInfo) corpus() (data, input []interface{}) {
+	data = info.payload().Records()
+	if info.Converter == nil {
+		return data, data
+	}
+	input = make([]interface{}, len(data))
+	for i, d := range data {
+		input[i] = info.Converter.New()
+		info.Converter.From(input[i], d)
+	}
+	return data, input
+}
+
+// newValue allocates a decode target for the serializer.
+func (info SerializerInfo) newValue() interface{} {
+	if info.Converter == nil {
+		return info.payload().New()
+	}
+	return info.Converter.New()
+}
+
+// diff compares got, as decoded by the serializer, to the payload record want
+// within the serializer's tolerance.
+func (info SerializerInfo) diff(want, got interface{}) FieldDiffs {
+	if info.Converter != nil {
+		o := info.payload().New()
+		info.Converter.To(o, got)
+		got = o
+	}
+	return Diff(want, got, info.Tolerance)
+}
+
+// sameEncoding reports whether buf, encoded from the payload record want,
+// matches ser. Encodings of unordered payloads only need to have the same
+// length and decode to the same record.
+func (info SerializerInfo) sameEncoding(s Serializer, want interface{}, buf, ser []byte) bool {
+	if bytes.Equal(buf, ser) {
+		return true
+	}
+	if !info.payload().Unordered || len(buf) != len(ser) {
+		return false
+	}
+	o := info.newValue()
+	return s.Unmarshal(buf, o) == nil && len(info.diff(want, o)) == 0
+}
+
+// validating reports whether the timed loops check every record they decode.
+func (info SerializerInfo) validating() bool {
+	return validate != "" && !info.baseline
+}
+
+var (
+	// serializers holds the serializers registered with RegisterSerializer,
+	// and once registered has run those of RegisterPayload as well.
+	serializers  []SerializerInfo
+	adaptations  []adaptation
+	registerOnce sync.Once
+)
+
+// RegisterSerializer adds a serializer to the set driven by
+// BenchmarkSerializers, or BenchmarkPayloads for payloads other than A.
+// Serializers run in registration order. A serializer supporting several
+// payloads is registered once for each of them, under the same name.
+func RegisterSerializer(info SerializerInfo) {
+	if info.Name == "" || info.New == nil {
+		panic("goserbench: serializer needs a name and a factory")
+	}
+	checkUnique(serializers, info)
+	serializers = append(serializers, info)
+}
+
+// checkUnique panics if info has the name of a serializer of its payload in
+// infos.
+func checkUnique(infos []SerializerInfo, info SerializerInfo) {
+	for _, s := range infos {
+		if s.Name == info.Name && s.payload() == info.payload() {
+			panic("goserbench: serializer " + info.Name + " registered twice for payload " + info.payload().Name)
+		}
+	}
+}
+
+// Adaptation is how RegisterPayload adapts a serializer of A to another
+// payload. Its zero value keeps the serializer as registered for A.
+type Adaptation struct {
+	// New replaces the factory of serializers bound to the type they encode,
+	// such as Gob.
+	New func() Serializer
+	// Converter maps the records of the payload to the generated type of the
+	// codec. The converters of A are only kept for payloads of A.
+	Converter *Converter
+	// Skip is why the serializer cannot represent the payload. It is then
+	// registered as skipped, so that the benchmarks log the reason.
+	Skip string
+}
+
+type adaptation struct {
+	payload *Payload
+	adapt   map[string]Adaptation
+}
+
+// RegisterPayload registers every serializer of A for payload p as well,
+// under the same name and adapted by the entry of adapt with that name.
+// Serializers added to p with RegisterSerializer run after them.
+func RegisterPayload(p *Payload, adapt map[string]Adaptation) {
+	adaptations = append(adaptations, adaptation{p, adapt})
+}
+
+// registered returns every registered serializer: those of A, then those of
+// each payload of RegisterPayload, then the rest, in registration order.
+// The serializers of A are only known once every init has run, so the ones
+// of RegisterPayload are added on the first call.
+func registered() []SerializerInfo {
+	registerOnce.Do(func() {
+		var ofA, all []SerializerInfo
+		for _, info := range serializers {
+			if info.Payload == nil {
+				ofA = append(ofA, info)
+			}
+		}
+		all = append(all, ofA...)
+		done := map[*Payload]bool{payloadA: true}
+		for _, a := range adaptations {
+			for name := range a.adapt {
+				if !hasSerializer(ofA, name) {
+					panic("goserbench: payload " + a.payload.Name + " adapts unknown serializer " + name)
+				}
+			}
+			_, isA := a.payload.New().(*A)
+			for _, info := range ofA {
+				info.Payload = a.payload
+				if !isA {
+					info.Converter = nil
+					info.skip = info.OnlyA
+				}
+				ad := a.adapt[info.Name]
+				if ad.New != nil {
+					info.New = ad.New
+				}
+				if ad.Converter != nil {
+					info.Converter = ad.Converter
+				}
+				if ad.Skip != "" {
+					info.skip = ad.Skip
+				}
+				checkUnique(all, info)
+				all = append(all, info)
+			}
+			for _, info := range serializers {
+				if info.Payload == a.payload {
+					checkUnique(all, info)
+					all = append(all, info)
+				}
+			}
+			done[a.payload] = true
+		}
+		for _, info := range serializers {
+			if !done[info.payload()] {
+				all = append(all, info)
+			}
+		}
+		serializers = all
+	})
+	return serializers
+}
+
+func hasSerializer(infos []SerializerInfo, name string) bool {
+	for _, info := range infos {
+		if info.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// serializersOfA returns the registrations of every serializer of A. As it
+// calls registered, it must not be called from an init function.
+func serializersOfA() []SerializerInfo {
+	var infos []SerializerInfo
+	for _, info := range registered() {
+		if info.Payload == nil {
+			infos = append(infos, info)
+		}
+	}
+	return infos
+}
+
+// interfaces converts a typed slice such as []*A into []interface{}.
+func interfaces(slice interface{}) []interface{} {
+	v := reflect.ValueOf(slice)
+	r := make([]interface{}, v.Len())
+	for i := range r {
+		r[i] = v.Index(i).Interface()
+	}
+	return r
+}
+
+var payloadA = &Payload{
+	Name:     "A",
+	Generate: func() []interface{} { return interfaces(generate()) },
+	New:      func() interface{} { return &A{} },
+}
+
+// payloads returns every payload some serializer is registered for, in
+// registration order.
+func payloads() []*Payload {
+	var ps []*Payload
+	seen := map[*Payload]bool{}
+	for _, info := range registered() {
+		if p := info.payload(); !seen[p] {
+			seen[p] = true
+			ps = append(ps, p)
+		}
+	}
+	return ps
+}
+
+// benchmarked returns the serializers run by the benchmarks of payload p: the
+// ones registered for it, preceded by the Baseline measuring the harness
+// overhead.
+func benchmarked(p *Payload) []SerializerInfo {
+	baseline := baselineInfo
+	baseline.Payload = p
+	infos := []SerializerInfo{baseline}
+	for _, info := range registered() {
+		if info.payload() == p {
+			infos = append(infos, info)
+		}
+	}
+	return infos
+}
+
+var printConfigOnce sync.Once
+
+// printConfig prints the Go version and the versions of the modules from
+// the build info of the test binary as configuration lines of the benchmark
+// output, next to the goos, goarch and cpu lines of go test, for
+// cmd/benchreport to stamp the results with. Replaced modules are printed
+// as path@version=>replacement.
+func printConfig() {
+	printConfigOnce.Do(func() {
+		fmt.Printf("go: %s\n", runtime.Version())
+		bi, ok := debug.ReadBuildInfo()
+		if !ok || len(bi.Deps) == 0 {
+			return
+		}
+		var mods []string
+		for _, d := range bi.Deps {
+			m := d.Path + "@" + d.Version
+			if r := d.Replace; r != nil {
+				m += "=>" + r.Path
+				if r.Version != "" && r.Version != "(devel)" {
+					m += "@" + r.Version
+				}
+			}
+			mods = append(mods, m)
+		}
+		fmt.Printf("modules: %s\n", strings.Join(mods, " "))
+	})
+}
+
+func BenchmarkSerializers(b *testing.B) {
+	printConfig()
+	b.Logf("corpus seed %d", *seed)
+	for _, info := range benchmarked(payloadA) {
+		info := info
+		b.Run(info.Name, func(b *testing.B) { benchSerializer(b, info) })
+	}
+}
+
+// BenchmarkPayloads runs the same benchmarks as BenchmarkSerializers against
+// every other payload, as BenchmarkPayloads/<Payload>/<Serializer>/<Variant>.
+// Serializers that cannot represent a payload skip it, logging why.
+func BenchmarkPayloads(b *testing.B) {
+	printConfig()
+	b.Logf("corpus seed %d", *seed)
+	for _, p := range payloads() {
+		if p == payloadA {
+			continue
+		}
+		p := p
+		b.Run(p.Name, func(b *testing.B) {
+			for _, info := range benchmarked(p) {
+				info := info
+				b.Run(info.Name, func(b *testing.B) { benchSerializer(b, info) })
+			}
+		})
+	}
+}
+
+func benchSerializer(b *testing.B, info SerializerInfo) {
+	if info.skip != "" {
+		b.Skip(info.skip)
+	}
+	b.Run("Marshal", func(b *testing.B) { benchMarshal(b, info) })
+	if _, ok := info.New().(MarshalToSerializer); ok {
+		b.Run("MarshalReuse", func(b *testing.B) { benchMarshalReuse(b, info) })
+	}
+	b.Run("Unmarshal", func(b *testing.B) { benchUnmarshal(b, info) })
+	b.Run("RoundTrip", func(b *testing.B) { benchRoundTrip(b, info) })
+	if info.Converter != nil {
+		name := info.payload().Name
+		b.Run("ConvertFrom"+name, func(b *testing.B) { benchConvertFrom(b, info) })
+		b.Run("ConvertTo"+name, func(b *testing.B) { benchConvertTo(b, info) })
+	}
+}
+
+// marshalCorpus encodes every record of data, failing tb if the serializer
+// reports an error or, unless the payload is sparse, produces no output for
+// any of them. The encodings are copied, so serializers may return internal
+// buffers.
+func marshalCorpus(tb testing.TB, info SerializerInfo, s Serializer, data []interface{}) [][]byte {
+	ser := make([][]byte, len(data))
+	for i, d := range data {
+		o, err := s.Marshal(d)
+		if err != nil {
+			tb.Fatalf("%s failed to marshal record %d: %s\n%v", info.Name, i, err, d)
+		}
+		if len(o) == 0 && !info.payload().Sparse {
+			tb.Fatalf("%s produced no output for record %d:\n%v", info.Name, i, d)
+		}
+		t := make([]byte, len(o))
+		copy(t, o)
+		ser[i] = t
+	}
+	return ser
+}
+
+// reportSize reports the mean, smallest and largest encoded message of the
+// corpus as custom metrics, and sets the throughput to the mean size so that
+// go test also prints MB/s.
+func reportSize(b *testing.B, info SerializerInfo, ser [][]byte) {
+	min, max, total := len(ser[0]), 0, 0
+	for _, d := range ser {
+		if len(d) < min {
+			min = len(d)
+		}
+		if len(d) > max {
+			max = len(d)
+		}
+		total += len(d)
+	}
+	mean := float64(total) / float64(len(ser))
+	b.SetBytes(int64(math.Round(mean)))
+	b.ReportMetric(mean, "B/msg")
+	b.ReportMetric(float64(min), "minB/msg")
+	b.ReportMetric(float64(max), "maxB/msg")
+	if k := info.payload().PerMessage; k > 0 && !info.baseline {
+		b.ReportMetric(mean/float64(k), "B/rec")
+	}
+}
+
+func benchMarshal(b *testing.B, info SerializerInfo) {
+	b.StopTimer()
+	s := info.New()
+	_, data := info.corpus()
+	reportSize(b, info, marshalCorpus(b, info, s, data))
+	idx := indexes(len(data))
+	lat := newLatencies()
+	b.ReportAllocs()
+	gc := startGCStats()
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		n := idx[i&indexMask]
+		start := lat.start()
+		if _, err := s.Marshal(data[n]); err != nil {
+			b.Fatalf("%s failed to marshal record %d: %s\n%v", info.Name, n, err, data[n])
+		}
+		lat.record(start)
+	}
+	reportNet(b, info, "Marshal")
+	lat.report(b)
+	gc.report(b)
+}
+
+// benchMarshalReuse measures the steady state of a serializer encoding into
+// the same buffer over and over again.
+func benchMarshalReuse(b *testing.B, info SerializerInfo) {
 	b.StopTimer()
-	data := generate()
+	s := info.New()
+	m := s.(MarshalToSerializer)
+	records, data := info.corpus()
+	ser := marshalCorpus(b, info, s, data)
+	reportSize(b, info, ser)
+	idx := indexes(len(data))
+	var buf []byte
+	var err error
+	lat := newLatencies()
 	b.ReportAllocs()
+	gc := startGCStats()
 	b.StartTimer()
 	for i := 0; i < b.N; i++ {
-		s.Marshal(data[rand.Intn(len(data))])
+		n := idx[i&indexMask]
+		start := lat.start()
+		buf, err = m.MarshalTo(buf, data[n])
+		if err != nil {
+			b.Fatalf("%s failed to marshal record %d into a reused buffer: %s\n%v", info.Name, n, err, data[n])
+		}
+		lat.record(start)
+		if info.validating() && !info.sameEncoding(s, records[n], buf, ser[n]) {
+			b.Fatalf("%s encoded record %d differently into a reused buffer:\n%x\n%x", info.Name, n, ser[n], buf)
+		}
 	}
+	reportNet(b, info, "MarshalReuse")
+	lat.report(b)
+	gc.report(b)
 }
 
 func cmpTags(a, b map[string]string) bool {
@@ -94,36 +656,198 @@ func cmpAliases(a, b []string) bool {
 	return true
 }
 
-func benchUnmarshal(b *testing.B, s Serializer) {
+func benchUnmarshal(b *testing.B, info SerializerInfo) {
 	b.StopTimer()
-	data := generate()
-	ser := make([][]byte, len(data))
-	for i, d := range data {
-		o := s.Marshal(d)
-		t := make([]byte, len(o))
-		copy(t, o)
-		ser[i] = t
+	s := info.New()
+	data, input := info.corpus()
+	ser := marshalCorpus(b, info, s, input)
+	reportSize(b, info, ser)
+	if info.payload().Binary && !info.baseline {
+		reportAliasing(b, info, s, ser)
 	}
+	idx := indexes(len(ser))
+	lat := newLatencies()
 	b.ReportAllocs()
+	gc := startGCStats()
 	b.StartTimer()
 	for i := 0; i < b.N; i++ {
-		n := rand.Intn(len(ser))
-		o := &A{}
+		n := idx[i&indexMask]
+		start := lat.start()
+		o := info.newValue()
 		err := s.Unmarshal(ser[n], o)
 		if err != nil {
-			b.Fatalf("%s failed to unmarshal: %s (%s)", s, err, ser[n])
+			b.Fatalf("%s failed to unmarshal record %d: %s (%x)", info.Name, n, err, ser[n])
 		}
+		lat.record(start)
 		// Validate unmarshalled data.
+		if info.validating() {
+			if d := info.diff(data[n], o); len(d) > 0 {
+				b.Fatalf("%s unmarshaled record %d differently:\n%s", info.Name, n, d)
+			}
+		}
+	}
+	reportNet(b, info, "Unmarshal")
+	lat.report(b)
+	gc.report(b)
+}
+
+func benchRoundTrip(b *testing.B, info SerializerInfo) {
+	b.StopTimer()
+	s := info.New()
+	data, input := info.corpus()
+	reportSize(b, info, marshalCorpus(b, info, s, input))
+	idx := indexes(len(data))
+	lat := newLatencies()
+	b.ReportAllocs()
+	gc := startGCStats()
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		n := idx[i&indexMask]
+		start := lat.start()
+		d, err := s.Marshal(input[n])
+		if err != nil {
+			b.Fatalf("%s failed to marshal record %d: %s\n%v", info.Name, n, err, data[n])
+		}
+		o := info.newValue()
+		err = s.Unmarshal(d, o)
+		if err != nil {
+			b.Fatalf("%s failed to unmarshal record %d: %s (%x)", info.Name, n, err, d)
+		}
+		lat.record(start)
+		if info.validating() {
+			if d := info.diff(data[n], o); len(d) > 0 {
+				b.Fatalf("%s round tripped record %d differently:\n%s", info.Name, n, d)
+			}
+		}
+	}
+	reportNet(b, info, "RoundTrip")
+	lat.report(b)
+	gc.report(b)
+}
+
+// benchConvertFrom measures converting a payload record such as A into a
+// freshly allocated value of the serializer's generated type, the cost paid
+// before Marshal when a service's own model is the payload type.
+func benchConvertFrom(b *testing.B, info SerializerInfo) {
+	b.StopTimer()
+	conv := info.Converter
+	data := info.payload().Records()
+	idx := indexes(len(data))
+	b.ReportAllocs()
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		o := conv.New()
+		conv.From(o, data[idx[i&indexMask]])
+	}
+}
+
+// benchConvertTo measures converting a decoded generated value back into a
+// freshly allocated payload record, the cost paid after Unmarshal.
+func benchConvertTo(b *testing.B, info SerializerInfo) {
+	b.StopTimer()
+	conv := info.Converter
+	data, input := info.corpus()
+	idx := indexes(len(input))
+	b.ReportAllocs()
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		n := idx[i&indexMask]
+		o := info.payload().New()
+		conv.To(o, input[n])
 		if validate != "" {
-			i := data[n]
-			correct := o.Name == i.Name && o.Phone == i.Phone && o.Siblings == i.Siblings && o.Spouse == i.Spouse && o.Money == i.Money && o.BirthDay.Equal(i.BirthDay) //&& cmpTags(o.Tags, i.Tags) && cmpAliases(o.Aliases, i.Aliases)
-			if !correct {
-				b.Fatalf("unmarshaled object differed:\n%v\n%v", i, o)
+			if d := Diff(data[n], o, info.Tolerance); len(d) > 0 {
+				b.Fatalf("%s converted record %d differently:\n%s", info.Name, n, d)
 			}
 		}
 	}
 }
 
+// BenchmarkSerializersParallel measures how serializers scale across
+// goroutines. Marshal and Unmarshal give every goroutine its own instance
+// from the factory; the Shared variants make all goroutines use a single
+// instance and only run for serializers declared goroutine-safe.
+func BenchmarkSerializersParallel(b *testing.B) {
+	printConfig()
+	b.Logf("corpus seed %d", *seed)
+	for _, info := range benchmarked(payloadA) {
+		info := info
+		b.Run(info.Name, func(b *testing.B) {
+			b.Run("Marshal", func(b *testing.B) { benchMarshalParallel(b, info, nil) })
+			b.Run("Unmarshal", func(b *testing.B) { benchUnmarshalParallel(b, info, nil) })
+			if info.GoroutineSafe {
+				b.Run("SharedMarshal", func(b *testing.B) { benchMarshalParallel(b, info, info.New()) })
+				b.Run("SharedUnmarshal", func(b *testing.B) { benchUnmarshalParallel(b, info, info.New()) })
+			}
+		})
+	}
+}
+
+// instance returns shared, or a fresh serializer if shared is nil.
+func (info SerializerInfo) instance(shared Serializer) Serializer {
+	if shared != nil {
+		return shared
+	}
+	return info.New()
+}
+
+// parallelVariant names the parallel loop of op for reportNet, which calibrates
+// the loops sharing one instance apart from the others.
+func parallelVariant(op string, shared Serializer) string {
+	if shared != nil {
+		return "ParallelShared" + op
+	}
+	return "Parallel" + op
+}
+
+func benchMarshalParallel(b *testing.B, info SerializerInfo, shared Serializer) {
+	b.StopTimer()
+	_, data := info.corpus()
+	reportSize(b, info, marshalCorpus(b, info, info.New(), data))
+	idx := indexes(len(data))
+	b.ReportAllocs()
+	b.StartTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		s := info.instance(shared)
+		// Goroutines start at different points of the same sequence.
+		for i := rand.Intn(indexSize); pb.Next(); i++ {
+			n := idx[i&indexMask]
+			if _, err := s.Marshal(data[n]); err != nil {
+				b.Errorf("%s failed to marshal record %d: %s\n%v", info.Name, n, err, data[n])
+				return
+			}
+		}
+	})
+	reportNet(b, info, parallelVariant("Marshal", shared))
+}
+
+func benchUnmarshalParallel(b *testing.B, info SerializerInfo, shared Serializer) {
+	b.StopTimer()
+	data, input := info.corpus()
+	ser := marshalCorpus(b, info, info.New(), input)
+	reportSize(b, info, ser)
+	idx := indexes(len(ser))
+	b.ReportAllocs()
+	b.StartTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		s := info.instance(shared)
+		for i := rand.Intn(indexSize); pb.Next(); i++ {
+			n := idx[i&indexMask]
+			o := info.newValue()
+			if err := s.Unmarshal(ser[n], o); err != nil {
+				b.Errorf("%s failed to unmarshal record %d: %s (%x)", info.Name, n, err, ser[n])
+				return
+			}
+			if info.validating() {
+				if d := info.diff(data[n], o); len(d) > 0 {
+					b.Errorf("%s unmarshaled record %d differently:\n%s", info.Name, n, d)
+					return
+				}
+			}
+		}
+	})
+	reportNet(b, info, parallelVariant("Unmarshal", shared))
+}
+
 func TestMessage(t *testing.T) {
 	println(`
 A test suite for benchmarking various Go serialization methods.
@@ -133,6 +857,118 @@ See README.md for details on running the benchmarks.
 
 }
 
+// TestRoundTrip checks every registered serializer against the whole corpus
+// of each of its payloads, so that plain go test catches correctness
+// regressions without running any benchmark.
+func TestRoundTrip(t *testing.T) {
+	for _, info := range registered() {
+		info := info
+		t.Run(info.payload().Name+"/"+info.Name, func(t *testing.T) {
+			if info.skip != "" {
+				t.Skip(info.skip)
+			}
+			s := info.New()
+			data, input := info.corpus()
+			ser := marshalCorpus(t, info, s, input)
+			m, reuse := s.(MarshalToSerializer)
+			var buf []byte
+			for n, d := range ser {
+				if reuse {
+					var err error
+					buf, err = m.MarshalTo(buf, input[n])
+					if err != nil {
+						t.Fatalf("%s failed to marshal record %d into a reused buffer: %s", info.Name, n, err)
+					}
+					if !info.sameEncoding(s, data[n], buf, d) {
+						t.Fatalf("%s encoded record %d differently into a reused buffer:\n%x\n%x", info.Name, n, d, buf)
+					}
+				}
+				o := info.newValue()
+				if err := s.Unmarshal(d, o); err != nil {
+					t.Fatalf("%s failed to unmarshal record %d: %s (%x)", info.Name, n, err, d)
+				}
+				if diff := info.diff(data[n], o); len(diff) > 0 {
+					t.Fatalf("%s round tripped record %d differently:\n%s", info.Name, n, diff)
+				}
+			}
+		})
+	}
+}
+
+// Memcpy
+
+// MemcpySerializer writes the fields of A back to back, prefixing strings with
+// their length. It is no real format, just the least work any serializer of A
+// has to do, and marks the floor the other results can be compared to.
+type MemcpySerializer struct{}
+
+func memcpySize(a *A) int {
+	return 4 + len(a.Name) + 4 + len(a.Phone) + 8 + 8 + 1 + 8
+}
+
+func (m MemcpySerializer) Marshal(o interface{}) ([]byte, error) {
+	return m.MarshalTo(nil, o)
+}
+
+func (MemcpySerializer) MarshalTo(buf []byte, o interface{}) ([]byte, error) {
+	a := o.(*A)
+	buf = grow(buf, memcpySize(a))
+	le := binary.LittleEndian
+	i := 0
+	le.PutUint32(buf[i:], uint32(len(a.Name)))
+	i += 4 + copy(buf[i+4:], a.Name)
+	le.PutUint32(buf[i:], uint32(len(a.Phone)))
+	i += 4 + copy(buf[i+4:], a.Phone)
+	le.PutUint64(buf[i:], uint64(a.BirthDay.UnixNano()))
+	le.PutUint64(buf[i+8:], uint64(a.Siblings))
+	buf[i+16] = 0
+	if a.Spouse {
+		buf[i+16] = 1
+	}
+	le.PutUint64(buf[i+17:], math.Float64bits(a.Money))
+	return buf, nil
+}
+
+func (MemcpySerializer) Unmarshal(d []byte, o interface{}) error {
+	a := o.(*A)
+	le := binary.LittleEndian
+	str := func() (string, error) {
+		if len(d) < 4 || uint64(len(d)-4) < uint64(le.Uint32(d)) {
+			return "", io.ErrUnexpectedEOF
+		}
+		n := 4 + int(le.Uint32(d))
+		s := string(d[4:n])
+		d = d[n:]
+		return s, nil
+	}
+	var err error
+	if a.Name, err = str(); err != nil {
+		return err
+	}
+	if a.Phone, err = str(); err != nil {
+		return err
+	}
+	if len(d) < 25 {
+		return io.ErrUnexpectedEOF
+	}
+	a.BirthDay = time.Unix(0, int64(le.Uint64(d)))
+	a.Siblings = int(le.Uint64(d[8:]))
+	a.Spouse = d[16] != 0
+	a.Money = math.Float64frombits(le.Uint64(d[17:]))
+	return nil
+}
+
+func (MemcpySerializer) String() string { return "Memcpy" }
+
+func init() {
+	RegisterSerializer(SerializerInfo{
+		Name:          "Memcpy",
+		New:           func() Serializer { return MemcpySerializer{} },
+		GoroutineSafe: true,
+		OnlyA:         "Memcpy only knows the layout of A",
+	})
+}
+
 // github.com/niubaoshu/gotiny
 
 type GotinySerializer struct {
@@ -140,8 +976,8 @@ type GotinySerializer struct {
 	dec *gotiny.Decoder
 }
 
-func (g GotinySerializer) Marshal(o interface{}) []byte {
-	return g.enc.Encode(o)
+func (g GotinySerializer) Marshal(o interface{}) ([]byte, error) {
+	return g.enc.Encode(o), nil
 }
 
 func (g GotinySerializer) Unmarshal(d []byte, o interface{}) error {
@@ -159,78 +995,54 @@ func NewGotinySerializer(o interface{}) Serializer {
 	}
 }
 
-func BenchmarkGotinyMarshal(b *testing.B) {
-	benchMarshal(b, NewGotinySerializer(A{}))
-}
-
-func BenchmarkGotinyUnmarshal(b *testing.B) {
-	benchUnmarshal(b, NewGotinySerializer(A{}))
-}
-
-func generateNoTimeA() []*NoTimeA {
-	a := make([]*NoTimeA, 0, 1000)
-	for i := 0; i < 1000; i++ {
-		a = append(a, &NoTimeA{
-			Name:     randString(16),
-			BirthDay: time.Now().UnixNano(),
-			Phone:    randString(10),
-			Siblings: rand.Intn(5),
-			Spouse:   rand.Intn(2) == 1,
-			Money:    rand.Float64(),
-		})
-	}
-	return a
-}
-
-func BenchmarkGotinyNoTimeMarshal(b *testing.B) {
-	b.StopTimer()
-	s := NewGotinySerializer(NoTimeA{})
-	data := generateNoTimeA()
-	b.ReportAllocs()
-	b.StartTimer()
-	for i := 0; i < b.N; i++ {
-		s.Marshal(data[rand.Intn(len(data))])
-	}
-}
-
-func BenchmarkGotinyNoTimeUnmarshal(b *testing.B) {
-	b.StopTimer()
-	s := NewGotinySerializer(NoTimeA{})
-	data := generateNoTimeA()
-	ser := make([][]byte, len(data))
-	for i, d := range data {
-		o := s.Marshal(d)
-		t := make([]byte, len(o))
-		copy(t, o)
-		ser[i] = t
-	}
-	b.ReportAllocs()
-	b.StartTimer()
-	for i := 0; i < b.N; i++ {
-		n := rand.Intn(len(ser))
-		o := &NoTimeA{}
-		err := s.Unmarshal(ser[n], o)
-		if err != nil {
-			b.Fatalf("%s failed to unmarshal: %s (%s)", s, err, ser[n])
+var noTimeAConverter = &Converter{
+	New: func() interface{} { return &NoTimeA{} },
+	From: func(dst, src interface{}) {
+		a := src.(*A)
+		*dst.(*NoTimeA) = NoTimeA{
+			Name:     a.Name,
+			BirthDay: a.BirthDay.UnixNano(),
+			Phone:    a.Phone,
+			Siblings: a.Siblings,
+			Spouse:   a.Spouse,
+			Money:    a.Money,
 		}
-		// Validate unmarshalled data.
-		if validate != "" {
-			i := data[n]
-			correct := o.Name == i.Name && o.Phone == i.Phone && o.Siblings == i.Siblings && o.Spouse == i.Spouse && o.Money == i.Money && o.BirthDay == i.BirthDay //&& cmpTags(o.Tags, i.Tags) && cmpAliases(o.Aliases, i.Aliases)
-			if !correct {
-				b.Fatalf("unmarshaled object differed:\n%v\n%v", i, o)
-			}
+	},
+	To: func(dst, src interface{}) {
+		a := dst.(*A)
+		o := src.(*NoTimeA)
+		*a = A{
+			Name:     o.Name,
+			BirthDay: time.Unix(0, o.BirthDay),
+			Phone:    o.Phone,
+			Siblings: o.Siblings,
+			Spouse:   o.Spouse,
+			Money:    o.Money,
 		}
-	}
+	},
+}
+
+func init() {
+	RegisterSerializer(SerializerInfo{
+		Name:    "Gotiny",
+		Package: "github.com/niubaoshu/gotiny",
+		New:     func() Serializer { return NewGotinySerializer(A{}) },
+	})
+	RegisterSerializer(SerializerInfo{
+		Name:      "GotinyNoTime",
+		Package:   "github.com/niubaoshu/gotiny",
+		New:       func() Serializer { return NewGotinySerializer(NoTimeA{}) },
+		Converter: noTimeAConverter,
+		OnlyA:     "GotinyNoTime only differs from Gotiny in how A stores its timestamp",
+	})
 }
 
 // github.com/tinylib/msgp
 
 type MsgpSerializer struct{}
 
-func (m MsgpSerializer) Marshal(o interface{}) []byte {
-	out, _ := o.(msgp.Marshaler).MarshalMsg(nil)
-	return out
+func (m MsgpSerializer) Marshal(o interface{}) ([]byte, error) {
+	return o.(msgp.Marshaler).MarshalMsg(nil)
 }
 
 func (m MsgpSerializer) Unmarshal(d []byte, o interface{}) error {
@@ -238,23 +1050,28 @@ func (m MsgpSerializer) Unmarshal(d []byte, o interface{}) error {
 	return err
 }
 
-func (m MsgpSerializer) String() string { return "Msgp" }
-
-func BenchmarkMsgpMarshal(b *testing.B) {
-	benchMarshal(b, MsgpSerializer{})
+func (m MsgpSerializer) MarshalTo(buf []byte, o interface{}) ([]byte, error) {
+	return o.(msgp.Marshaler).MarshalMsg(buf[:0])
 }
 
-func BenchmarkMsgpUnmarshal(b *testing.B) {
-	benchUnmarshal(b, MsgpSerializer{})
+func (m MsgpSerializer) String() string { return "Msgp" }
+
+func init() {
+	RegisterSerializer(SerializerInfo{
+		Name:          "Msgp",
+		Package:       "github.com/tinylib/msgp",
+		Generated:     true,
+		New:           func() Serializer { return MsgpSerializer{} },
+		GoroutineSafe: true,
+	})
 }
 
 // gopkg.in/vmihailenco/msgpack.v2
 
 type VmihailencoMsgpackSerializer struct{}
 
-func (m VmihailencoMsgpackSerializer) Marshal(o interface{}) []byte {
-	d, _ := vmihailenco.Marshal(o)
-	return d
+func (m VmihailencoMsgpackSerializer) Marshal(o interface{}) ([]byte, error) {
+	return vmihailenco.Marshal(o)
 }
 
 func (m VmihailencoMsgpackSerializer) Unmarshal(d []byte, o interface{}) error {
@@ -265,21 +1082,21 @@ func (m VmihailencoMsgpackSerializer) String() string {
 	return "vmihailenco-msgpack"
 }
 
-func BenchmarkVmihailencoMsgpackMarshal(b *testing.B) {
-	benchMarshal(b, VmihailencoMsgpackSerializer{})
-}
-
-func BenchmarkVmihailencoMsgpackUnmarshal(b *testing.B) {
-	benchUnmarshal(b, VmihailencoMsgpackSerializer{})
+func init() {
+	RegisterSerializer(SerializerInfo{
+		Name:          "VmihailencoMsgpack",
+		Package:       "gopkg.in/vmihailenco/msgpack.v2",
+		New:           func() Serializer { return VmihailencoMsgpackSerializer{} },
+		GoroutineSafe: true,
+	})
 }
 
 // encoding/json
 
 type JsonSerializer struct{}
 
-func (j JsonSerializer) Marshal(o interface{}) []byte {
-	d, _ := json.Marshal(o)
-	return d
+func (j JsonSerializer) Marshal(o interface{}) ([]byte, error) {
+	return json.Marshal(o)
 }
 
 func (j JsonSerializer) Unmarshal(d []byte, o interface{}) error {
@@ -290,21 +1107,21 @@ func (j JsonSerializer) String() string {
 	return "json"
 }
 
-func BenchmarkJsonMarshal(b *testing.B) {
-	benchMarshal(b, JsonSerializer{})
-}
-
-func BenchmarkJsonUnmarshal(b *testing.B) {
-	benchUnmarshal(b, JsonSerializer{})
+func init() {
+	RegisterSerializer(SerializerInfo{
+		Name:          "Json",
+		Package:       "encoding/json",
+		New:           func() Serializer { return JsonSerializer{} },
+		GoroutineSafe: true,
+	})
 }
 
 // github.com/json-iterator/go
 
 type JsonIterSerializer struct{}
 
-func (j JsonIterSerializer) Marshal(o interface{}) []byte {
-	d, _ := jsoniterFast.Marshal(o)
-	return d
+func (j JsonIterSerializer) Marshal(o interface{}) ([]byte, error) {
+	return jsoniterFast.Marshal(o)
 }
 
 func (j JsonIterSerializer) Unmarshal(d []byte, o interface{}) error {
@@ -315,45 +1132,54 @@ func (j JsonIterSerializer) String() string {
 	return "jsoniter"
 }
 
-func BenchmarkJsonIterMarshal(b *testing.B) {
-	benchMarshal(b, JsonIterSerializer{})
-}
-
-func BenchmarkJsonIterUnmarshal(b *testing.B) {
-	benchUnmarshal(b, JsonIterSerializer{})
+func init() {
+	RegisterSerializer(SerializerInfo{
+		Name:          "JsonIter",
+		Package:       "github.com/json-iterator/go",
+		New:           func() Serializer { return JsonIterSerializer{} },
+		GoroutineSafe: true,
+		// ConfigFastest writes floats with 6 decimal digits.
+		Tolerance: Tolerance{Float: 1e-6},
+	})
 }
 
 // github.com/mailru/easyjson
 
+// easyJSONMessage is implemented by every type generated with easyjson.
+type easyJSONMessage interface {
+	MarshalJSONEasyJSON() ([]byte, error)
+	UnmarshalJSONEasyJSON(data []byte) error
+}
+
 type EasyJSONSerializer struct{}
 
-func (m EasyJSONSerializer) Marshal(o interface{}) []byte {
-	out, _ := o.(*A).MarshalJSONEasyJSON()
-	return out
+func (m EasyJSONSerializer) Marshal(o interface{}) ([]byte, error) {
+	return o.(easyJSONMessage).MarshalJSONEasyJSON()
 }
 
 func (m EasyJSONSerializer) Unmarshal(d []byte, o interface{}) error {
-	err := o.(*A).UnmarshalJSONEasyJSON(d)
+	err := o.(easyJSONMessage).UnmarshalJSONEasyJSON(d)
 	return err
 }
 
 func (m EasyJSONSerializer) String() string { return "EasyJson" }
 
-func BenchmarkEasyJsonMarshal(b *testing.B) {
-	benchMarshal(b, EasyJSONSerializer{})
-}
-
-func BenchmarkEasyJsonUnmarshal(b *testing.B) {
-	benchUnmarshal(b, EasyJSONSerializer{})
+func init() {
+	RegisterSerializer(SerializerInfo{
+		Name:          "EasyJson",
+		Package:       "github.com/mailru/easyjson",
+		Generated:     true,
+		New:           func() Serializer { return EasyJSONSerializer{} },
+		GoroutineSafe: true,
+	})
 }
 
 // gopkg.in/mgo.v2/bson
 
 type BsonSerializer struct{}
 
-func (m BsonSerializer) Marshal(o interface{}) []byte {
-	d, _ := bson.Marshal(o)
-	return d
+func (m BsonSerializer) Marshal(o interface{}) ([]byte, error) {
+	return bson.Marshal(o)
 }
 
 func (m BsonSerializer) Unmarshal(d []byte, o interface{}) error {
@@ -364,12 +1190,15 @@ func (j BsonSerializer) String() string {
 	return "bson"
 }
 
-func BenchmarkBsonMarshal(b *testing.B) {
-	benchMarshal(b, BsonSerializer{})
-}
-
-func BenchmarkBsonUnmarshal(b *testing.B) {
-	benchUnmarshal(b, BsonSerializer{})
+func init() {
+	RegisterSerializer(SerializerInfo{
+		Name:          "Bson",
+		Package:       "gopkg.in/mgo.v2/bson",
+		New:           func() Serializer { return BsonSerializer{} },
+		GoroutineSafe: true,
+		// BSON datetimes are milliseconds since the epoch.
+		Tolerance: Tolerance{Time: time.Millisecond},
+	})
 }
 
 // encoding/gob
@@ -380,13 +1209,10 @@ type GobSerializer struct {
 	dec *gob.Decoder
 }
 
-func (g *GobSerializer) Marshal(o interface{}) []byte {
+func (g *GobSerializer) Marshal(o interface{}) ([]byte, error) {
 	g.b.Reset()
 	err := g.enc.Encode(o)
-	if err != nil {
-		panic(err)
-	}
-	return g.b.Bytes()
+	return g.b.Bytes(), err
 }
 
 func (g *GobSerializer) Unmarshal(d []byte, o interface{}) error {
@@ -400,30 +1226,30 @@ func (g GobSerializer) String() string {
 	return "gob"
 }
 
-func NewGobSerializer() *GobSerializer {
+// NewGobSerializer returns a gob serializer for values of the same type as
+// prototype. The type is transmitted once up front, so that every message
+// only carries the values.
+func NewGobSerializer(prototype interface{}) *GobSerializer {
 	s := &GobSerializer{}
 	s.enc = gob.NewEncoder(&s.b)
 	s.dec = gob.NewDecoder(&s.b)
-	err := s.enc.Encode(A{})
+	err := s.enc.Encode(prototype)
 	if err != nil {
 		panic(err)
 	}
-	var a A
-	err = s.dec.Decode(&a)
+	err = s.dec.Decode(reflect.New(reflect.TypeOf(prototype)).Interface())
 	if err != nil {
 		panic(err)
 	}
 	return s
 }
 
-func BenchmarkGobMarshal(b *testing.B) {
-	s := NewGobSerializer()
-	benchMarshal(b, s)
-}
-
-func BenchmarkGobUnmarshal(b *testing.B) {
-	s := NewGobSerializer()
-	benchUnmarshal(b, s)
+func init() {
+	RegisterSerializer(SerializerInfo{
+		Name:    "Gob",
+		Package: "encoding/gob",
+		New:     func() Serializer { return NewGobSerializer(A{}) },
+	})
 }
 
 // github.com/ugorji/go/codec
@@ -440,10 +1266,10 @@ func NewUgorjiCodecSerializer(name string, h codec.Handle) *UgorjiCodecSerialize
 	}
 }
 
-func (u *UgorjiCodecSerializer) Marshal(o interface{}) []byte {
+func (u *UgorjiCodecSerializer) Marshal(o interface{}) ([]byte, error) {
 	var bs []byte
-	codec.NewEncoderBytes(&bs, u.h).Encode(o)
-	return bs
+	err := codec.NewEncoderBytes(&bs, u.h).Encode(o)
+	return bs, err
 }
 
 func (u *UgorjiCodecSerializer) Unmarshal(d []byte, o interface{}) error {
@@ -454,28 +1280,25 @@ func (u *UgorjiCodecSerializer) String() string {
 	return "ugorjicodec-" + u.name
 }
 
-func BenchmarkUgorjiCodecMsgpackMarshal(b *testing.B) {
-	s := NewUgorjiCodecSerializer("msgpack", &codec.MsgpackHandle{})
-	benchMarshal(b, s)
-}
-
-func BenchmarkUgorjiCodecMsgpackUnmarshal(b *testing.B) {
-	s := NewUgorjiCodecSerializer("msgpack", &codec.MsgpackHandle{})
-	benchUnmarshal(b, s)
-}
-
-func BenchmarkUgorjiCodecBincMarshal(b *testing.B) {
-	h := &codec.BincHandle{}
-	h.AsSymbols = 0
-	s := NewUgorjiCodecSerializer("binc", h)
-	benchMarshal(b, s)
-}
-
-func BenchmarkUgorjiCodecBincUnmarshal(b *testing.B) {
-	h := &codec.BincHandle{}
-	h.AsSymbols = 0
-	s := NewUgorjiCodecSerializer("binc", h)
-	benchUnmarshal(b, s)
+func init() {
+	RegisterSerializer(SerializerInfo{
+		Name:    "UgorjiCodecMsgpack",
+		Package: "github.com/ugorji/go/codec",
+		New: func() Serializer {
+			return NewUgorjiCodecSerializer("msgpack", &codec.MsgpackHandle{})
+		},
+		GoroutineSafe: true,
+	})
+	RegisterSerializer(SerializerInfo{
+		Name:    "UgorjiCodecBinc",
+		Package: "github.com/ugorji/go/codec",
+		New: func() Serializer {
+			h := &codec.BincHandle{}
+			h.AsSymbols = 0
+			return NewUgorjiCodecSerializer("binc", h)
+		},
+		GoroutineSafe: true,
+	})
 }
 
 // github.com/google/flatbuffers/go
@@ -484,7 +1307,7 @@ type FlatBufferSerializer struct {
 	builder *flatbuffers.Builder
 }
 
-func (s *FlatBufferSerializer) Marshal(o interface{}) []byte {
+func (s *FlatBufferSerializer) Marshal(o interface{}) ([]byte, error) {
 	a := o.(*A)
 	builder := s.builder
 
@@ -505,7 +1328,7 @@ func (s *FlatBufferSerializer) Marshal(o interface{}) []byte {
 	FlatBufferAAddSpouse(builder, spouse)
 	FlatBufferAAddMoney(builder, a.Money)
 	builder.Finish(FlatBufferAEnd(builder))
-	return builder.Bytes[builder.Head():]
+	return builder.Bytes[builder.Head():], nil
 }
 
 func (s *FlatBufferSerializer) Unmarshal(d []byte, i interface{}) error {
@@ -525,21 +1348,77 @@ func (s *FlatBufferSerializer) String() string {
 	return "FlatBuffer"
 }
 
-func BenchmarkFlatBuffersMarshal(b *testing.B) {
-	benchMarshal(b, &FlatBufferSerializer{flatbuffers.NewBuilder(0)})
+// FlatBuffers has no Go type of its own to convert records to: its encoding
+// is the generated type, a table read in place by accessors. Its Converter
+// builds the table from a record and reads it back with the FlatBuffers
+// serializer of the payload, which ConvertFromA and ConvertToA time, while
+// Marshal hands over the finished table and Unmarshal locates its root.
+
+// flatBufferTable is a finished FlatBuffers table, the generated type of the
+// FlatBuffers Converter.
+type flatBufferTable struct {
+	flatbuffers.Table
+}
+
+type FlatBufferTableSerializer struct{}
+
+func (FlatBufferTableSerializer) Marshal(o interface{}) ([]byte, error) {
+	return o.(*flatBufferTable).Bytes, nil
+}
+
+func (FlatBufferTableSerializer) Unmarshal(d []byte, o interface{}) error {
+	t := o.(*flatBufferTable)
+	t.Bytes = d
+	t.Pos = flatbuffers.GetUOffsetT(d)
+	return nil
+}
+
+func (FlatBufferTableSerializer) String() string {
+	return "FlatBuffer"
+}
+
+// flatBufferConverter returns the Converter of FlatBuffers for the payload
+// that the serializers of newSerializer build tables from and read them
+// into. Every table gets a builder of its own, as every record of the other
+// Converters gets a fresh value of their generated type.
+func flatBufferConverter(newSerializer func() Serializer) *Converter {
+	reader := newSerializer()
+	return &Converter{
+		New: func() interface{} { return &flatBufferTable{} },
+		From: func(dst, src interface{}) {
+			d, err := newSerializer().Marshal(src)
+			if err != nil {
+				panic(err)
+			}
+			FlatBufferTableSerializer{}.Unmarshal(d, dst)
+		},
+		To: func(dst, src interface{}) {
+			if err := reader.Unmarshal(src.(*flatBufferTable).Bytes, dst); err != nil {
+				panic(err)
+			}
+		},
+	}
 }
 
-func BenchmarkFlatBuffersUnmarshal(b *testing.B) {
-	benchUnmarshal(b, &FlatBufferSerializer{flatbuffers.NewBuilder(0)})
+func init() {
+	RegisterSerializer(SerializerInfo{
+		Name:          "FlatBuffers",
+		Package:       "github.com/google/flatbuffers/go",
+		Generated:     true,
+		New:           func() Serializer { return FlatBufferTableSerializer{} },
+		GoroutineSafe: true,
+		Converter: flatBufferConverter(func() Serializer {
+			return &FlatBufferSerializer{flatbuffers.NewBuilder(0)}
+		}),
+	})
 }
 
 // github.com/DeDiS/protobuf
 
 type ProtobufSerializer struct{}
 
-func (m ProtobufSerializer) Marshal(o interface{}) []byte {
-	d, _ := protobuf.Encode(o)
-	return d
+func (m ProtobufSerializer) Marshal(o interface{}) ([]byte, error) {
+	return protobuf.Encode(o)
 }
 
 func (m ProtobufSerializer) Unmarshal(d []byte, o interface{}) error {
@@ -550,338 +1429,379 @@ func (m ProtobufSerializer) String() string {
 	return "protobuf"
 }
 
-func BenchmarkProtobufMarshal(b *testing.B) {
-	benchMarshal(b, ProtobufSerializer{})
-}
-
-func BenchmarkProtobufUnmarshal(b *testing.B) {
-	benchUnmarshal(b, ProtobufSerializer{})
+func init() {
+	RegisterSerializer(SerializerInfo{
+		Name:          "Protobuf",
+		Package:       "github.com/DeDiS/protobuf",
+		New:           func() Serializer { return ProtobufSerializer{} },
+		GoroutineSafe: true,
+	})
 }
 
 // github.com/golang/protobuf
 
-func generateProto() []*ProtoBufA {
-	a := make([]*ProtoBufA, 0, 1000)
-	for i := 0; i < 1000; i++ {
-		a = append(a, &ProtoBufA{
-			Name:     proto.String(randString(16)),
-			BirthDay: proto.Int64(time.Now().UnixNano()),
-			Phone:    proto.String(randString(10)),
-			Siblings: proto.Int32(rand.Int31n(5)),
-			Spouse:   proto.Bool(rand.Intn(2) == 1),
-			Money:    proto.Float64(rand.Float64()),
-		})
-	}
-	return a
+type GoprotobufSerializer struct{}
+
+func (m GoprotobufSerializer) Marshal(o interface{}) ([]byte, error) {
+	return proto.Marshal(o.(proto.Message))
 }
 
-func BenchmarkGoprotobufMarshal(b *testing.B) {
-	b.StopTimer()
-	data := generateProto()
-	b.ReportAllocs()
-	b.StartTimer()
-	for i := 0; i < b.N; i++ {
-		proto.Marshal(data[rand.Intn(len(data))])
-	}
+func (m GoprotobufSerializer) Unmarshal(d []byte, o interface{}) error {
+	return proto.Unmarshal(d, o.(proto.Message))
 }
 
-func BenchmarkGoprotobufUnmarshal(b *testing.B) {
-	b.StopTimer()
-	data := generateProto()
-	ser := make([][]byte, len(data))
-	for i, d := range data {
-		ser[i], _ = proto.Marshal(d)
-	}
-	b.ReportAllocs()
-	b.StartTimer()
-	for i := 0; i < b.N; i++ {
-		n := rand.Intn(len(ser))
-		o := &ProtoBufA{}
-		err := proto.Unmarshal(ser[n], o)
-		if err != nil {
-			b.Fatalf("goprotobuf failed to unmarshal: %s (%s)", err, ser[n])
+func (m GoprotobufSerializer) String() string {
+	return "goprotobuf"
+}
+
+var protoBufAConverter = &Converter{
+	New: func() interface{} { return &ProtoBufA{} },
+	From: func(dst, src interface{}) {
+		a := src.(*A)
+		*dst.(*ProtoBufA) = ProtoBufA{
+			Name:     proto.String(a.Name),
+			BirthDay: proto.Int64(a.BirthDay.UnixNano()),
+			Phone:    proto.String(a.Phone),
+			Siblings: proto.Int32(int32(a.Siblings)),
+			Spouse:   proto.Bool(a.Spouse),
+			Money:    proto.Float64(a.Money),
 		}
-		// Validate unmarshalled data.
-		if validate != "" {
-			i := data[n]
-			correct := *o.Name == *i.Name && *o.Phone == *i.Phone && *o.Siblings == *i.Siblings && *o.Spouse == *i.Spouse && *o.Money == *i.Money && *o.BirthDay == *i.BirthDay //&& cmpTags(o.Tags, i.Tags) && cmpAliases(o.Aliases, i.Aliases)
-			if !correct {
-				b.Fatalf("unmarshaled object differed:\n%v\n%v", i, o)
-			}
+	},
+	To: func(dst, src interface{}) {
+		a := dst.(*A)
+		o := src.(*ProtoBufA)
+		*a = A{
+			Name:     o.GetName(),
+			BirthDay: time.Unix(0, o.GetBirthDay()),
+			Phone:    o.GetPhone(),
+			Siblings: int(o.GetSiblings()),
+			Spouse:   o.GetSpouse(),
+			Money:    o.GetMoney(),
 		}
-	}
+	},
+}
+
+func init() {
+	RegisterSerializer(SerializerInfo{
+		Name:          "Goprotobuf",
+		Package:       "github.com/golang/protobuf",
+		Generated:     true,
+		New:           func() Serializer { return GoprotobufSerializer{} },
+		GoroutineSafe: true,
+		Converter:     protoBufAConverter,
+	})
 }
 
 // github.com/gogo/protobuf/proto
 
-func generateGogoProto() []*GogoProtoBufA {
-	a := make([]*GogoProtoBufA, 0, 1000)
-	for i := 0; i < 1000; i++ {
-		a = append(a, &GogoProtoBufA{
-			Name:     randString(16),
-			BirthDay: time.Now().UnixNano(),
-			Phone:    randString(10),
-			Siblings: rand.Int31n(5),
-			Spouse:   rand.Intn(2) == 1,
-			Money:    rand.Float64(),
-		})
-	}
-	return a
+// gogoMessage is implemented by the types generated by gogofaster, which
+// can encode into a caller supplied buffer.
+type gogoMessage interface {
+	proto.Message
+	Size() int
+	MarshalTo(dAtA []byte) (int, error)
 }
 
-func BenchmarkGogoprotobufMarshal(b *testing.B) {
-	b.StopTimer()
-	data := generateGogoProto()
-	b.ReportAllocs()
-	b.StartTimer()
-	for i := 0; i < b.N; i++ {
-		proto.Marshal(data[rand.Intn(len(data))])
-	}
+type GogoprotobufSerializer struct{}
+
+func (m GogoprotobufSerializer) Marshal(o interface{}) ([]byte, error) {
+	return proto.Marshal(o.(proto.Message))
 }
 
-func BenchmarkGogoprotobufUnmarshal(b *testing.B) {
-	b.StopTimer()
-	data := generateGogoProto()
-	ser := make([][]byte, len(data))
-	for i, d := range data {
-		ser[i], _ = proto.Marshal(d)
-	}
-	b.ReportAllocs()
-	b.StartTimer()
-	for i := 0; i < b.N; i++ {
-		n := rand.Intn(len(ser))
-		o := &GogoProtoBufA{}
-		err := proto.Unmarshal(ser[n], o)
-		if err != nil {
-			b.Fatalf("goprotobuf failed to unmarshal: %s (%s)", err, ser[n])
+func (m GogoprotobufSerializer) Unmarshal(d []byte, o interface{}) error {
+	return proto.Unmarshal(d, o.(proto.Message))
+}
+
+func (m GogoprotobufSerializer) MarshalTo(buf []byte, o interface{}) ([]byte, error) {
+	a := o.(gogoMessage)
+	buf = grow(buf, a.Size())
+	n, err := a.MarshalTo(buf)
+	return buf[:n], err
+}
+
+func (m GogoprotobufSerializer) String() string {
+	return "gogoprotobuf"
+}
+
+var gogoProtoBufAConverter = &Converter{
+	New: func() interface{} { return &GogoProtoBufA{} },
+	From: func(dst, src interface{}) {
+		a := src.(*A)
+		*dst.(*GogoProtoBufA) = GogoProtoBufA{
+			Name:     a.Name,
+			BirthDay: a.BirthDay.UnixNano(),
+			Phone:    a.Phone,
+			Siblings: int32(a.Siblings),
+			Spouse:   a.Spouse,
+			Money:    a.Money,
 		}
-		// Validate unmarshalled data.
-		if validate != "" {
-			i := data[n]
-			correct := o.Name == i.Name && o.Phone == i.Phone && o.Siblings == i.Siblings && o.Spouse == i.Spouse && o.Money == i.Money && o.BirthDay == i.BirthDay //&& cmpTags(o.Tags, i.Tags) && cmpAliases(o.Aliases, i.Aliases)
-			if !correct {
-				b.Fatalf("unmarshaled object differed:\n%v\n%v", i, o)
-			}
+	},
+	To: func(dst, src interface{}) {
+		a := dst.(*A)
+		o := src.(*GogoProtoBufA)
+		*a = A{
+			Name:     o.Name,
+			BirthDay: time.Unix(0, o.BirthDay),
+			Phone:    o.Phone,
+			Siblings: int(o.Siblings),
+			Spouse:   o.Spouse,
+			Money:    o.Money,
 		}
-	}
+	},
+}
+
+func init() {
+	RegisterSerializer(SerializerInfo{
+		Name:          "Gogoprotobuf",
+		Package:       "github.com/gogo/protobuf/proto",
+		Generated:     true,
+		New:           func() Serializer { return GogoprotobufSerializer{} },
+		GoroutineSafe: true,
+		Converter:     gogoProtoBufAConverter,
+	})
 }
 
 // github.com/pascaldekloe/colfer
 
-func generateColfer() []*ColferA {
-	a := make([]*ColferA, 0, 1000)
-	for i := 0; i < 1000; i++ {
-		a = append(a, &ColferA{
-			Name:     randString(16),
-			BirthDay: time.Now(),
-			Phone:    randString(10),
-			Siblings: rand.Int31n(5),
-			Spouse:   rand.Intn(2) == 1,
-			Money:    rand.Float64(),
-		})
-	}
-	return a
+// colferMessage is implemented by every type generated by colf.
+type colferMessage interface {
+	MarshalBinary() ([]byte, error)
+	UnmarshalBinary(data []byte) error
+	MarshalLen() int
+	MarshalTo(buf []byte) int
 }
 
-func BenchmarkColferMarshal(b *testing.B) {
-	data := generateColfer()
-	b.ReportAllocs()
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		n := rand.Intn(len(data))
-		_, err := data[n].MarshalBinary()
-		if err != nil {
-			b.Fatalf("Colfer failed to marshal %#v: %s", data[n], err)
-		}
-	}
+type ColferSerializer struct{}
+
+func (m ColferSerializer) Marshal(o interface{}) ([]byte, error) {
+	return o.(colferMessage).MarshalBinary()
 }
 
-func BenchmarkColferUnmarshal(b *testing.B) {
-	data := generateColfer()
-	ser := make([][]byte, len(data))
-	for i, d := range data {
-		var err error
-		ser[i], err = d.MarshalBinary()
-		if err != nil {
-			b.Fatal(err)
-		}
-	}
-	b.ReportAllocs()
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		n := rand.Intn(len(ser))
-		o := &ColferA{}
-		if err := o.UnmarshalBinary(ser[n]); err != nil {
-			b.Fatalf("Colfer failed to unmarshal %#v: %s", data[n], err)
+func (m ColferSerializer) Unmarshal(d []byte, o interface{}) error {
+	return o.(colferMessage).UnmarshalBinary(d)
+}
+
+func (m ColferSerializer) MarshalTo(buf []byte, o interface{}) ([]byte, error) {
+	a := o.(colferMessage)
+	buf = grow(buf, a.MarshalLen())
+	a.MarshalTo(buf)
+	return buf, nil
+}
+
+func (m ColferSerializer) String() string {
+	return "Colfer"
+}
+
+var colferAConverter = &Converter{
+	New: func() interface{} { return &ColferA{} },
+	From: func(dst, src interface{}) {
+		a := src.(*A)
+		*dst.(*ColferA) = ColferA{
+			Name:     a.Name,
+			BirthDay: a.BirthDay,
+			Phone:    a.Phone,
+			Siblings: int32(a.Siblings),
+			Spouse:   a.Spouse,
+			Money:    a.Money,
 		}
-		if validate != "" {
-			i := data[n]
-			correct := o.Name == i.Name && o.Phone == i.Phone && o.Siblings == i.Siblings && o.Spouse == i.Spouse && o.Money == i.Money && o.BirthDay.Equal(i.BirthDay)
-			if !correct {
-				b.Fatalf("unmarshaled object differed:\n%v\n%v", i, o)
-			}
+	},
+	To: func(dst, src interface{}) {
+		a := dst.(*A)
+		o := src.(*ColferA)
+		*a = A{
+			Name:     o.Name,
+			BirthDay: o.BirthDay,
+			Phone:    o.Phone,
+			Siblings: int(o.Siblings),
+			Spouse:   o.Spouse,
+			Money:    o.Money,
 		}
-	}
+	},
+}
+
+func init() {
+	RegisterSerializer(SerializerInfo{
+		Name:          "Colfer",
+		Package:       "github.com/pascaldekloe/colfer",
+		Generated:     true,
+		New:           func() Serializer { return ColferSerializer{} },
+		GoroutineSafe: true,
+		Converter:     colferAConverter,
+	})
 }
 
 // github.com/andyleap/gencode
 
-func generateGencode() []*GencodeA {
-	a := make([]*GencodeA, 0, 1000)
-	for i := 0; i < 1000; i++ {
-		a = append(a, &GencodeA{
-			Name:     randString(16),
-			BirthDay: time.Now(),
-			Phone:    randString(10),
-			Siblings: rand.Int63n(5),
-			Spouse:   rand.Intn(2) == 1,
-			Money:    rand.Float64(),
-		})
-	}
-	return a
+// gencodeMessage is implemented by both the safe and unsafe generated types.
+type gencodeMessage interface {
+	Marshal(buf []byte) ([]byte, error)
+	Unmarshal(buf []byte) (uint64, error)
 }
 
-func BenchmarkGencodeMarshal(b *testing.B) {
-	b.StopTimer()
-	data := generateGencode()
-	b.ReportAllocs()
-	b.StartTimer()
-	for i := 0; i < b.N; i++ {
-		data[rand.Intn(len(data))].Marshal(nil)
-	}
+type GencodeSerializer struct{}
+
+func (m GencodeSerializer) Marshal(o interface{}) ([]byte, error) {
+	return o.(gencodeMessage).Marshal(nil)
 }
 
-func BenchmarkGencodeUnmarshal(b *testing.B) {
-	b.StopTimer()
-	data := generateGencode()
-	ser := make([][]byte, len(data))
-	for i, d := range data {
-		ser[i], _ = d.Marshal(nil)
-	}
-	b.ReportAllocs()
-	b.StartTimer()
-	for i := 0; i < b.N; i++ {
-		n := rand.Intn(len(ser))
-		o := &GencodeA{}
-		_, err := o.Unmarshal(ser[n])
-		if err != nil {
-			b.Fatalf("gencode failed to unmarshal: %s (%s)", err, ser[n])
-		}
-		// Validate unmarshalled data.
-		if validate != "" {
-			i := data[n]
-			correct := o.Name == i.Name && o.Phone == i.Phone && o.Siblings == i.Siblings && o.Spouse == i.Spouse && o.Money == i.Money && o.BirthDay.Equal(i.BirthDay) //&& cmpTags(o.Tags, i.Tags) && cmpAliases(o.Aliases, i.Aliases)
-			if !correct {
-				b.Fatalf("unmarshaled object differed:\n%v\n%v", i, o)
-			}
-		}
-	}
+func (m GencodeSerializer) Unmarshal(d []byte, o interface{}) error {
+	_, err := o.(gencodeMessage).Unmarshal(d)
+	return err
 }
 
-func generateGencodeUnsafe() []*GencodeUnsafeA {
-	a := make([]*GencodeUnsafeA, 0, 1000)
-	for i := 0; i < 1000; i++ {
-		a = append(a, &GencodeUnsafeA{
-			Name:     randString(16),
-			BirthDay: time.Now().UnixNano(),
-			Phone:    randString(10),
-			Siblings: rand.Int63n(5),
-			Spouse:   rand.Intn(2) == 1,
-			Money:    rand.Float64(),
-		})
-	}
-	return a
+func (m GencodeSerializer) MarshalTo(buf []byte, o interface{}) ([]byte, error) {
+	return o.(gencodeMessage).Marshal(buf)
 }
 
-func BenchmarkGencodeUnsafeMarshal(b *testing.B) {
-	b.StopTimer()
-	data := generateGencodeUnsafe()
-	b.ReportAllocs()
-	b.StartTimer()
-	for i := 0; i < b.N; i++ {
-		data[rand.Intn(len(data))].Marshal(nil)
-	}
+func (m GencodeSerializer) String() string {
+	return "gencode"
 }
 
-func BenchmarkGencodeUnsafeUnmarshal(b *testing.B) {
-	b.StopTimer()
-	data := generateGencodeUnsafe()
-	ser := make([][]byte, len(data))
-	for i, d := range data {
-		ser[i], _ = d.Marshal(nil)
-	}
-	b.ReportAllocs()
-	b.StartTimer()
-	for i := 0; i < b.N; i++ {
-		n := rand.Intn(len(ser))
-		o := &GencodeUnsafeA{}
-		_, err := o.Unmarshal(ser[n])
-		if err != nil {
-			b.Fatalf("gencode failed to unmarshal: %s (%s)", err, ser[n])
+var gencodeAConverter = &Converter{
+	New: func() interface{} { return &GencodeA{} },
+	From: func(dst, src interface{}) {
+		a := src.(*A)
+		*dst.(*GencodeA) = GencodeA{
+			Name:     a.Name,
+			BirthDay: a.BirthDay,
+			Phone:    a.Phone,
+			Siblings: int64(a.Siblings),
+			Spouse:   a.Spouse,
+			Money:    a.Money,
 		}
-		// Validate unmarshalled data.
-		if validate != "" {
-			i := data[n]
-			correct := o.Name == i.Name && o.Phone == i.Phone && o.Siblings == i.Siblings && o.Spouse == i.Spouse && o.Money == i.Money && o.BirthDay == i.BirthDay //&& cmpTags(o.Tags, i.Tags) && cmpAliases(o.Aliases, i.Aliases)
-			if !correct {
-				b.Fatalf("unmarshaled object differed:\n%v\n%v", i, o)
-			}
+	},
+	To: func(dst, src interface{}) {
+		a := dst.(*A)
+		o := src.(*GencodeA)
+		*a = A{
+			Name:     o.Name,
+			BirthDay: o.BirthDay,
+			Phone:    o.Phone,
+			Siblings: int(o.Siblings),
+			Spouse:   o.Spouse,
+			Money:    o.Money,
 		}
-	}
+	},
+}
+
+var gencodeUnsafeAConverter = &Converter{
+	New: func() interface{} { return &GencodeUnsafeA{} },
+	From: func(dst, src interface{}) {
+		a := src.(*A)
+		*dst.(*GencodeUnsafeA) = GencodeUnsafeA{
+			Name:     a.Name,
+			BirthDay: a.BirthDay.UnixNano(),
+			Phone:    a.Phone,
+			Siblings: int64(a.Siblings),
+			Spouse:   a.Spouse,
+			Money:    a.Money,
+		}
+	},
+	To: func(dst, src interface{}) {
+		a := dst.(*A)
+		o := src.(*GencodeUnsafeA)
+		*a = A{
+			Name:     o.Name,
+			BirthDay: time.Unix(0, o.BirthDay),
+			Phone:    o.Phone,
+			Siblings: int(o.Siblings),
+			Spouse:   o.Spouse,
+			Money:    o.Money,
+		}
+	},
+}
+
+func init() {
+	RegisterSerializer(SerializerInfo{
+		Name:          "Gencode",
+		Package:       "github.com/andyleap/gencode",
+		Generated:     true,
+		New:           func() Serializer { return GencodeSerializer{} },
+		GoroutineSafe: true,
+		Converter:     gencodeAConverter,
+	})
+	RegisterSerializer(SerializerInfo{
+		Name:          "GencodeUnsafe",
+		Package:       "github.com/andyleap/gencode",
+		Generated:     true,
+		New:           func() Serializer { return GencodeSerializer{} },
+		GoroutineSafe: true,
+		Converter:     gencodeUnsafeAConverter,
+	})
 }
 
 // github.com/calmh/xdr
 
-func generateXDR() []*XDRA {
-	a := make([]*XDRA, 0, 1000)
-	for i := 0; i < 1000; i++ {
-		a = append(a, &XDRA{
-			Name:     randString(16),
-			BirthDay: time.Now().UnixNano(),
-			Phone:    randString(10),
-			Siblings: rand.Int31n(5),
-			Spouse:   rand.Intn(2) == 1,
-			Money:    math.Float64bits(rand.Float64()),
-		})
-	}
-	return a
+// xdrMessage is implemented by pointers to the types generated by genxdr.
+type xdrMessage interface {
+	XDRSize() int
+	MarshalXDR() ([]byte, error)
+	MarshalXDRInto(m *xdr.Marshaller) error
+	UnmarshalXDR(bs []byte) error
 }
 
-func BenchmarkXDR2Marshal(b *testing.B) {
-	b.StopTimer()
-	data := generateXDR()
-	b.ReportAllocs()
-	b.StartTimer()
-	for i := 0; i < b.N; i++ {
-		data[rand.Intn(len(data))].MarshalXDR()
-	}
+type XDRSerializer struct{}
+
+func (m XDRSerializer) Marshal(o interface{}) ([]byte, error) {
+	return o.(xdrMessage).MarshalXDR()
 }
 
-func BenchmarkXDR2Unmarshal(b *testing.B) {
-	b.StopTimer()
-	data := generateXDR()
-	ser := make([][]byte, len(data))
-	for i, d := range data {
-		ser[i] = d.MustMarshalXDR()
+func (m XDRSerializer) Unmarshal(d []byte, o interface{}) error {
+	return o.(xdrMessage).UnmarshalXDR(d)
+}
+
+func (m XDRSerializer) MarshalTo(buf []byte, o interface{}) ([]byte, error) {
+	a := o.(xdrMessage)
+	buf = grow(buf, a.XDRSize())
+	// The Marshaller skips padding without writing it, which must not keep
+	// what the previous message left there.
+	for i := range buf {
+		buf[i] = 0
 	}
-	b.ReportAllocs()
-	b.StartTimer()
-	for i := 0; i < b.N; i++ {
-		n := rand.Intn(len(ser))
-		o := XDRA{}
-		err := o.UnmarshalXDR(ser[n])
-		if err != nil {
-			b.Fatalf("xdr failed to unmarshal: %s (%s)", err, ser[n])
+	err := a.MarshalXDRInto(&xdr.Marshaller{Data: buf})
+	return buf, err
+}
+
+func (m XDRSerializer) String() string {
+	return "xdr"
+}
+
+var xdrAConverter = &Converter{
+	New: func() interface{} { return &XDRA{} },
+	From: func(dst, src interface{}) {
+		a := src.(*A)
+		*dst.(*XDRA) = XDRA{
+			Name:     a.Name,
+			BirthDay: a.BirthDay.UnixNano(),
+			Phone:    a.Phone,
+			Siblings: int32(a.Siblings),
+			Spouse:   a.Spouse,
+			Money:    math.Float64bits(a.Money),
 		}
-		// Validate unmarshalled data.
-		if validate != "" {
-			i := data[n]
-			correct := o.Name == i.Name && o.Phone == i.Phone && o.Siblings == i.Siblings && o.Spouse == i.Spouse && o.Money == i.Money && o.BirthDay == i.BirthDay
-			if !correct {
-				b.Fatalf("unmarshaled object differed:\n%v\n%v", i, o)
-			}
+	},
+	To: func(dst, src interface{}) {
+		a := dst.(*A)
+		o := src.(*XDRA)
+		*a = A{
+			Name:     o.Name,
+			BirthDay: time.Unix(0, o.BirthDay),
+			Phone:    o.Phone,
+			Siblings: int(o.Siblings),
+			Spouse:   o.Spouse,
+			Money:    math.Float64frombits(o.Money),
 		}
-	}
+	},
+}
+
+func init() {
+	RegisterSerializer(SerializerInfo{
+		Name:          "XDR2",
+		Package:       "github.com/calmh/xdr",
+		Generated:     true,
+		New:           func() Serializer { return XDRSerializer{} },
+		GoroutineSafe: true,
+		Converter:     xdrAConverter,
+	})
 }
 
 // github.com/ikkerens/ikeapack
@@ -895,74 +1815,74 @@ type IkeA struct {
 	Money    uint64
 }
 
-func generateIkeA() []*IkeA {
-	a := make([]*IkeA, 0, 1000)
-	for i := 0; i < 1000; i++ {
-		a = append(a, &IkeA{
-			Name:     randString(16),
-			BirthDay: time.Now().UnixNano(),
-			Phone:    randString(10),
-			Siblings: rand.Int31n(5),
-			Spouse:   rand.Intn(2) == 1,
-			Money:    math.Float64bits(rand.Float64()),
-		})
-	}
-	return a
+type IkeaSerializer struct {
+	buf bytes.Buffer
 }
 
-func BenchmarkIkeaMarshal(b *testing.B) {
-	b.StopTimer()
-	buf := new(bytes.Buffer)
-	buf.Grow(100)
-	data := generateIkeA()
-	b.ReportAllocs()
-	b.StartTimer()
-	for i := 0; i < b.N; i++ {
-		ikea.Pack(buf, data[rand.Intn(len(data))])
-		buf.Reset()
-	}
+func (m *IkeaSerializer) Marshal(o interface{}) ([]byte, error) {
+	m.buf.Reset()
+	err := ikea.Pack(&m.buf, o)
+	return m.buf.Bytes(), err
 }
 
-func BenchmarkIkeaUnmarshal(b *testing.B) {
-	b.StopTimer()
-	data := generateIkeA()
-	ser := make([][]byte, len(data))
-	for i, d := range data {
-		buf := new(bytes.Buffer)
-		ikea.Pack(buf, d)
-		ser[i] = buf.Bytes()
-	}
-	buf := new(bytes.Buffer)
-	buf.Grow(100)
-	b.ReportAllocs()
-	b.StartTimer()
-	for i := 0; i < b.N; i++ {
-		n := rand.Intn(len(ser))
-		o := IkeA{}
-		buf.Reset()
-		buf.Write(ser[n])
-		err := ikea.Unpack(buf, &o)
-		if err != nil {
-			b.Fatalf("ikea failed to unmarshal: %s (%s)", err, ser[n])
+func (m *IkeaSerializer) Unmarshal(d []byte, o interface{}) error {
+	m.buf.Reset()
+	m.buf.Write(d)
+	return ikea.Unpack(&m.buf, o)
+}
+
+func (m *IkeaSerializer) String() string {
+	return "ikea"
+}
+
+func NewIkeaSerializer() *IkeaSerializer {
+	s := &IkeaSerializer{}
+	s.buf.Grow(100)
+	return s
+}
+
+var ikeAConverter = &Converter{
+	New: func() interface{} { return &IkeA{} },
+	From: func(dst, src interface{}) {
+		a := src.(*A)
+		*dst.(*IkeA) = IkeA{
+			Name:     a.Name,
+			BirthDay: a.BirthDay.UnixNano(),
+			Phone:    a.Phone,
+			Siblings: int32(a.Siblings),
+			Spouse:   a.Spouse,
+			Money:    math.Float64bits(a.Money),
 		}
-		// Validate unmarshalled data.
-		if validate != "" {
-			i := data[n]
-			correct := o.Name == i.Name && o.Phone == i.Phone && o.Siblings == i.Siblings && o.Spouse == i.Spouse && o.Money == i.Money && o.BirthDay == i.BirthDay
-			if !correct {
-				b.Fatalf("unmarshaled object differed:\n%v\n%v", i, o)
-			}
+	},
+	To: func(dst, src interface{}) {
+		a := dst.(*A)
+		o := src.(*IkeA)
+		*a = A{
+			Name:     o.Name,
+			BirthDay: time.Unix(0, o.BirthDay),
+			Phone:    o.Phone,
+			Siblings: int(o.Siblings),
+			Spouse:   o.Spouse,
+			Money:    math.Float64frombits(o.Money),
 		}
-	}
+	},
+}
+
+func init() {
+	RegisterSerializer(SerializerInfo{
+		Name:      "Ikea",
+		Package:   "github.com/ikkerens/ikeapack",
+		New:       func() Serializer { return NewIkeaSerializer() },
+		Converter: ikeAConverter,
+	})
 }
 
 // github.com/shamaton/msgpack - as map
 
 type ShamatonMapMsgpackSerializer struct{}
 
-func (m ShamatonMapMsgpackSerializer) Marshal(o interface{}) []byte {
-	d, _ := shamaton.EncodeStructAsMap(o)
-	return d
+func (m ShamatonMapMsgpackSerializer) Marshal(o interface{}) ([]byte, error) {
+	return shamaton.EncodeStructAsMap(o)
 }
 
 func (m ShamatonMapMsgpackSerializer) Unmarshal(d []byte, o interface{}) error {
@@ -973,21 +1893,21 @@ func (m ShamatonMapMsgpackSerializer) String() string {
 	return "shamaton-map-msgpack"
 }
 
-func BenchmarkShamatonMapMsgpackMarshal(b *testing.B) {
-	benchMarshal(b, ShamatonMapMsgpackSerializer{})
-}
-
-func BenchmarkShamatonMapMsgpackUnmarshal(b *testing.B) {
-	benchUnmarshal(b, ShamatonMapMsgpackSerializer{})
+func init() {
+	RegisterSerializer(SerializerInfo{
+		Name:          "ShamatonMapMsgpack",
+		Package:       "github.com/shamaton/msgpack",
+		New:           func() Serializer { return ShamatonMapMsgpackSerializer{} },
+		GoroutineSafe: true,
+	})
 }
 
 // github.com/shamaton/msgpack - as array
 
 type ShamatonArrayMsgpackSerializer struct{}
 
-func (m ShamatonArrayMsgpackSerializer) Marshal(o interface{}) []byte {
-	d, _ := shamaton.EncodeStructAsArray(o)
-	return d
+func (m ShamatonArrayMsgpackSerializer) Marshal(o interface{}) ([]byte, error) {
+	return shamaton.EncodeStructAsArray(o)
 }
 
 func (m ShamatonArrayMsgpackSerializer) Unmarshal(d []byte, o interface{}) error {
@@ -998,10 +1918,11 @@ func (m ShamatonArrayMsgpackSerializer) String() string {
 	return "shamaton-array-msgpack"
 }
 
-func BenchmarkShamatonArrayMsgpackMarshal(b *testing.B) {
-	benchMarshal(b, ShamatonArrayMsgpackSerializer{})
-}
-
-func BenchmarkShamatonArrayMsgpackUnmarshal(b *testing.B) {
-	benchUnmarshal(b, ShamatonArrayMsgpackSerializer{})
+func init() {
+	RegisterSerializer(SerializerInfo{
+		Name:          "ShamatonArrayMsgpack",
+		Package:       "github.com/shamaton/msgpack",
+		New:           func() Serializer { return ShamatonArrayMsgpackSerializer{} },
+		GoroutineSafe: true,
+	})
 }
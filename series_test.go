@@ -0,0 +1,477 @@
+package goserbench
+
+import (
+	"encoding/binary"
+	"flag"
+	"math"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/google/flatbuffers/go"
+)
+
+// samples is the number of samples in each record of the Series payload.
+var samples = flag.Int("samples", int(envInt("SAMPLES", 128)), "samples per record of the Series payload (default $SAMPLES or 128)")
+
+// generateSeries returns records of evenly spaced, slightly jittered
+// timestamps with a random walk of values, as a metrics or sensor feed would
+// send them.
+func generateSeries() []*Series {
+	r := newRand()
+	n := *samples
+	p := make([]*Series, corpusLen(16+16*n))
+	for i := range p {
+		s := &Series{
+			Name:       randString(r, 16),
+			Timestamps: make([]int64, n),
+			Values:     make([]float64, n),
+		}
+		t := randTime(r).UnixNano()
+		v := r.Float64() * 100
+		for j := 0; j < n; j++ {
+			s.Timestamps[j] = t
+			s.Values[j] = v
+			t += int64(time.Second) + r.Int63n(int64(time.Millisecond))
+			v += r.NormFloat64()
+		}
+		p[i] = s
+	}
+	return p
+}
+
+var payloadSeries = &Payload{
+	Name:     "Series",
+	Generate: func() []interface{} { return interfaces(generateSeries()) },
+	New:      func() interface{} { return &Series{} },
+}
+
+// github.com/google/flatbuffers/go
+
+type FlatBufferSeriesSerializer struct {
+	builder *flatbuffers.Builder
+}
+
+func (s *FlatBufferSeriesSerializer) Marshal(o interface{}) ([]byte, error) {
+	a := o.(*Series)
+	builder := s.builder
+
+	builder.Reset()
+
+	FlatBufferSeriesStartTimestampsVector(builder, len(a.Timestamps))
+	for i := len(a.Timestamps) - 1; i >= 0; i-- {
+		builder.PrependInt64(a.Timestamps[i])
+	}
+	timestamps := builder.EndVector(len(a.Timestamps))
+	FlatBufferSeriesStartValuesVector(builder, len(a.Values))
+	for i := len(a.Values) - 1; i >= 0; i-- {
+		builder.PrependFloat64(a.Values[i])
+	}
+	values := builder.EndVector(len(a.Values))
+	name := builder.CreateString(a.Name)
+
+	FlatBufferSeriesStart(builder)
+	FlatBufferSeriesAddName(builder, name)
+	FlatBufferSeriesAddTimestamps(builder, timestamps)
+	FlatBufferSeriesAddValues(builder, values)
+	builder.Finish(FlatBufferSeriesEnd(builder))
+	return builder.Bytes[builder.Head():], nil
+}
+
+func (s *FlatBufferSeriesSerializer) Unmarshal(d []byte, i interface{}) error {
+	a := i.(*Series)
+	o := FlatBufferSeries{}
+	o.Init(d, flatbuffers.GetUOffsetT(d))
+	a.Name = string(o.Name())
+	a.Timestamps = make([]int64, o.TimestampsLength())
+	for j := range a.Timestamps {
+		a.Timestamps[j] = o.Timestamps(j)
+	}
+	a.Values = make([]float64, o.ValuesLength())
+	for j := range a.Values {
+		a.Values[j] = o.Values(j)
+	}
+	return nil
+}
+
+func (s *FlatBufferSeriesSerializer) String() string {
+	return "FlatBuffer"
+}
+
+// github.com/golang/protobuf
+
+var protoBufSeriesConverter = &Converter{
+	New: func() interface{} { return &ProtoBufSeries{} },
+	From: func(dst, src interface{}) {
+		a := src.(*Series)
+		*dst.(*ProtoBufSeries) = ProtoBufSeries{
+			Name:       proto.String(a.Name),
+			Timestamps: a.Timestamps,
+			Values:     a.Values,
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*ProtoBufSeries)
+		*dst.(*Series) = Series{
+			Name:       o.GetName(),
+			Timestamps: o.GetTimestamps(),
+			Values:     o.GetValues(),
+		}
+	},
+}
+
+// The variants of ProtoBufSeries store the timestamps unpacked, as fixed
+// eight bytes and as zigzag varints.
+
+var protoBufSeriesUnpackedConverter = &Converter{
+	New: func() interface{} { return &ProtoBufSeriesUnpacked{} },
+	From: func(dst, src interface{}) {
+		a := src.(*Series)
+		*dst.(*ProtoBufSeriesUnpacked) = ProtoBufSeriesUnpacked{
+			Name:       proto.String(a.Name),
+			Timestamps: a.Timestamps,
+			Values:     a.Values,
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*ProtoBufSeriesUnpacked)
+		*dst.(*Series) = Series{
+			Name:       o.GetName(),
+			Timestamps: o.GetTimestamps(),
+			Values:     o.GetValues(),
+		}
+	},
+}
+
+var protoBufSeriesFixedConverter = &Converter{
+	New: func() interface{} { return &ProtoBufSeriesFixed{} },
+	From: func(dst, src interface{}) {
+		a := src.(*Series)
+		*dst.(*ProtoBufSeriesFixed) = ProtoBufSeriesFixed{
+			Name:       proto.String(a.Name),
+			Timestamps: a.Timestamps,
+			Values:     a.Values,
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*ProtoBufSeriesFixed)
+		*dst.(*Series) = Series{
+			Name:       o.GetName(),
+			Timestamps: o.GetTimestamps(),
+			Values:     o.GetValues(),
+		}
+	},
+}
+
+var protoBufSeriesZigzagConverter = &Converter{
+	New: func() interface{} { return &ProtoBufSeriesZigzag{} },
+	From: func(dst, src interface{}) {
+		a := src.(*Series)
+		*dst.(*ProtoBufSeriesZigzag) = ProtoBufSeriesZigzag{
+			Name:       proto.String(a.Name),
+			Timestamps: a.Timestamps,
+			Values:     a.Values,
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*ProtoBufSeriesZigzag)
+		*dst.(*Series) = Series{
+			Name:       o.GetName(),
+			Timestamps: o.GetTimestamps(),
+			Values:     o.GetValues(),
+		}
+	},
+}
+
+// github.com/gogo/protobuf/proto
+
+var gogoProtoBufSeriesConverter = &Converter{
+	New: func() interface{} { return &GogoProtoBufSeries{} },
+	From: func(dst, src interface{}) {
+		a := src.(*Series)
+		*dst.(*GogoProtoBufSeries) = GogoProtoBufSeries{
+			Name:       a.Name,
+			Timestamps: a.Timestamps,
+			Values:     a.Values,
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*GogoProtoBufSeries)
+		*dst.(*Series) = Series{
+			Name:       o.Name,
+			Timestamps: o.Timestamps,
+			Values:     o.Values,
+		}
+	},
+}
+
+// The variants of GogoProtoBufSeries, as those of ProtoBufSeries.
+
+var gogoProtoBufSeriesUnpackedConverter = &Converter{
+	New: func() interface{} { return &GogoProtoBufSeriesUnpacked{} },
+	From: func(dst, src interface{}) {
+		a := src.(*Series)
+		*dst.(*GogoProtoBufSeriesUnpacked) = GogoProtoBufSeriesUnpacked{
+			Name:       a.Name,
+			Timestamps: a.Timestamps,
+			Values:     a.Values,
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*GogoProtoBufSeriesUnpacked)
+		*dst.(*Series) = Series{
+			Name:       o.Name,
+			Timestamps: o.Timestamps,
+			Values:     o.Values,
+		}
+	},
+}
+
+var gogoProtoBufSeriesFixedConverter = &Converter{
+	New: func() interface{} { return &GogoProtoBufSeriesFixed{} },
+	From: func(dst, src interface{}) {
+		a := src.(*Series)
+		*dst.(*GogoProtoBufSeriesFixed) = GogoProtoBufSeriesFixed{
+			Name:       a.Name,
+			Timestamps: a.Timestamps,
+			Values:     a.Values,
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*GogoProtoBufSeriesFixed)
+		*dst.(*Series) = Series{
+			Name:       o.Name,
+			Timestamps: o.Timestamps,
+			Values:     o.Values,
+		}
+	},
+}
+
+var gogoProtoBufSeriesZigzagConverter = &Converter{
+	New: func() interface{} { return &GogoProtoBufSeriesZigzag{} },
+	From: func(dst, src interface{}) {
+		a := src.(*Series)
+		*dst.(*GogoProtoBufSeriesZigzag) = GogoProtoBufSeriesZigzag{
+			Name:       a.Name,
+			Timestamps: a.Timestamps,
+			Values:     a.Values,
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*GogoProtoBufSeriesZigzag)
+		*dst.(*Series) = Series{
+			Name:       o.Name,
+			Timestamps: o.Timestamps,
+			Values:     o.Values,
+		}
+	},
+}
+
+// github.com/pascaldekloe/colfer
+
+// Colfer has no lists of integers, so ColferSeries carries the timestamps as
+// binary, eight big-endian bytes each, which the converter packs and unpacks.
+var colferSeriesConverter = &Converter{
+	New: func() interface{} { return &ColferSeries{} },
+	From: func(dst, src interface{}) {
+		a := src.(*Series)
+		o := dst.(*ColferSeries)
+		o.Name = a.Name
+		o.Timestamps = make([]byte, 8*len(a.Timestamps))
+		for i, t := range a.Timestamps {
+			binary.BigEndian.PutUint64(o.Timestamps[8*i:], uint64(t))
+		}
+		o.Values = a.Values
+	},
+	To: func(dst, src interface{}) {
+		a := dst.(*Series)
+		o := src.(*ColferSeries)
+		a.Name = o.Name
+		a.Timestamps = make([]int64, len(o.Timestamps)/8)
+		for i := range a.Timestamps {
+			a.Timestamps[i] = int64(binary.BigEndian.Uint64(o.Timestamps[8*i:]))
+		}
+		a.Values = o.Values
+	},
+}
+
+// github.com/andyleap/gencode
+
+var gencodeSeriesConverter = &Converter{
+	New: func() interface{} { return &GencodeSeries{} },
+	From: func(dst, src interface{}) {
+		a := src.(*Series)
+		*dst.(*GencodeSeries) = GencodeSeries{
+			Name:       a.Name,
+			Timestamps: a.Timestamps,
+			Values:     a.Values,
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*GencodeSeries)
+		*dst.(*Series) = Series{
+			Name:       o.Name,
+			Timestamps: o.Timestamps,
+			Values:     o.Values,
+		}
+	},
+}
+
+var gencodeUnsafeSeriesConverter = &Converter{
+	New: func() interface{} { return &GencodeUnsafeSeries{} },
+	From: func(dst, src interface{}) {
+		a := src.(*Series)
+		*dst.(*GencodeUnsafeSeries) = GencodeUnsafeSeries{
+			Name:       a.Name,
+			Timestamps: a.Timestamps,
+			Values:     a.Values,
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*GencodeUnsafeSeries)
+		*dst.(*Series) = Series{
+			Name:       o.Name,
+			Timestamps: o.Timestamps,
+			Values:     o.Values,
+		}
+	},
+}
+
+// floatBits and floatsFrom convert values to and from the IEEE 754 bits XDR
+// and ikeapack carry them as, as they do for the Money of A.
+func floatBits(f []float64) []uint64 {
+	u := make([]uint64, len(f))
+	for i, v := range f {
+		u[i] = math.Float64bits(v)
+	}
+	return u
+}
+
+func floatsFrom(u []uint64) []float64 {
+	f := make([]float64, len(u))
+	for i, v := range u {
+		f[i] = math.Float64frombits(v)
+	}
+	return f
+}
+
+// github.com/calmh/xdr
+
+var xdrSeriesConverter = &Converter{
+	New: func() interface{} { return &XDRSeries{} },
+	From: func(dst, src interface{}) {
+		a := src.(*Series)
+		*dst.(*XDRSeries) = XDRSeries{
+			Name:       a.Name,
+			Timestamps: a.Timestamps,
+			Values:     floatBits(a.Values),
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*XDRSeries)
+		*dst.(*Series) = Series{
+			Name:       o.Name,
+			Timestamps: o.Timestamps,
+			Values:     floatsFrom(o.Values),
+		}
+	},
+}
+
+// github.com/ikkerens/ikeapack
+
+type IkeSeries struct {
+	Name       string
+	Timestamps []int64
+	Values     []uint64
+}
+
+var ikeSeriesConverter = &Converter{
+	New: func() interface{} { return &IkeSeries{} },
+	From: func(dst, src interface{}) {
+		a := src.(*Series)
+		*dst.(*IkeSeries) = IkeSeries{
+			Name:       a.Name,
+			Timestamps: a.Timestamps,
+			Values:     floatBits(a.Values),
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*IkeSeries)
+		*dst.(*Series) = Series{
+			Name:       o.Name,
+			Timestamps: o.Timestamps,
+			Values:     floatsFrom(o.Values),
+		}
+	},
+}
+
+// The Series payload is registered for every serializer of A, under the same
+// names, followed by the protobuf variants encoding the timestamps otherwise.
+func init() {
+	RegisterPayload(payloadSeries, map[string]Adaptation{
+		"Gotiny": {New: func() Serializer { return NewGotinySerializer(Series{}) }},
+		"Gob":    {New: func() Serializer { return NewGobSerializer(Series{}) }},
+		"FlatBuffers": {Converter: flatBufferConverter(func() Serializer {
+			return &FlatBufferSeriesSerializer{builder: flatbuffers.NewBuilder(0)}
+		})},
+		"Goprotobuf":    {Converter: protoBufSeriesConverter},
+		"Gogoprotobuf":  {Converter: gogoProtoBufSeriesConverter},
+		"Colfer":        {Converter: colferSeriesConverter},
+		"Gencode":       {Converter: gencodeSeriesConverter},
+		"GencodeUnsafe": {Converter: gencodeUnsafeSeriesConverter},
+		"XDR2":          {Converter: xdrSeriesConverter},
+		"Ikea":          {Converter: ikeSeriesConverter},
+	})
+	for _, info := range []SerializerInfo{
+		{
+			Name:          "GoprotobufUnpacked",
+			Package:       "github.com/golang/protobuf",
+			Generated:     true,
+			New:           func() Serializer { return GoprotobufSerializer{} },
+			GoroutineSafe: true,
+			Converter:     protoBufSeriesUnpackedConverter,
+		},
+		{
+			Name:          "GoprotobufFixed",
+			Package:       "github.com/golang/protobuf",
+			Generated:     true,
+			New:           func() Serializer { return GoprotobufSerializer{} },
+			GoroutineSafe: true,
+			Converter:     protoBufSeriesFixedConverter,
+		},
+		{
+			Name:          "GoprotobufZigzag",
+			Package:       "github.com/golang/protobuf",
+			Generated:     true,
+			New:           func() Serializer { return GoprotobufSerializer{} },
+			GoroutineSafe: true,
+			Converter:     protoBufSeriesZigzagConverter,
+		},
+		{
+			Name:          "GogoprotobufUnpacked",
+			Package:       "github.com/gogo/protobuf/proto",
+			Generated:     true,
+			New:           func() Serializer { return GogoprotobufSerializer{} },
+			GoroutineSafe: true,
+			Converter:     gogoProtoBufSeriesUnpackedConverter,
+		},
+		{
+			Name:          "GogoprotobufFixed",
+			Package:       "github.com/gogo/protobuf/proto",
+			Generated:     true,
+			New:           func() Serializer { return GogoprotobufSerializer{} },
+			GoroutineSafe: true,
+			Converter:     gogoProtoBufSeriesFixedConverter,
+		},
+		{
+			Name:          "GogoprotobufZigzag",
+			Package:       "github.com/gogo/protobuf/proto",
+			Generated:     true,
+			New:           func() Serializer { return GogoprotobufSerializer{} },
+			GoroutineSafe: true,
+			Converter:     gogoProtoBufSeriesZigzagConverter,
+		},
+	} {
+		info.Payload = payloadSeries
+		RegisterSerializer(info)
+	}
+}
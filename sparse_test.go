@@ -0,0 +1,208 @@
+package goserbench
+
+import (
+	"flag"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+)
+
+// empty is the fraction of the fields of the Sparse payload left empty.
+var empty = flag.Float64("empty", envFloat("EMPTY", 0.5), "fraction of empty fields in the Sparse payload (default $EMPTY or 0.5)")
+
+// epoch is the empty BirthDay of the Sparse payload. The zero time.Time lies
+// outside the int64 nanoseconds most codecs carry times as, while the epoch
+// is their zero.
+var epoch = time.Unix(0, 0).UTC()
+
+// generateSparse returns the records of payload A with each field emptied
+// with probability *empty, so that they compare one to one with those of
+// BenchmarkSerializers.
+func generateSparse() []*A {
+	r := newRand()
+	records := payloadA.Records()
+	p := make([]*A, len(records))
+	for i, record := range records {
+		a := *record.(*A)
+		if r.Float64() < *empty {
+			a.Name = ""
+		}
+		if r.Float64() < *empty {
+			a.BirthDay = epoch
+		}
+		if r.Float64() < *empty {
+			a.Phone = ""
+		}
+		if r.Float64() < *empty {
+			a.Siblings = 0
+		}
+		if r.Float64() < *empty {
+			a.Spouse = false
+		}
+		if r.Float64() < *empty {
+			a.Money = 0
+		}
+		p[i] = &a
+	}
+	return p
+}
+
+var payloadSparse = &Payload{
+	Name:     "Sparse",
+	Generate: func() []interface{} { return interfaces(generateSparse()) },
+	New:      func() interface{} { return &A{} },
+	Sparse:   true,
+}
+
+// The OmitEmpty variants encode OmitEmptyA, whose struct tags let the JSON
+// and BSON encoders leave out zero fields.
+var omitEmptyAConverter = &Converter{
+	New: func() interface{} { return &OmitEmptyA{} },
+	From: func(dst, src interface{}) {
+		*dst.(*OmitEmptyA) = OmitEmptyA(*src.(*A))
+	},
+	To: func(dst, src interface{}) {
+		*dst.(*A) = A(*src.(*OmitEmptyA))
+	},
+}
+
+// The Optional variants encode the fields of A as optional instead of
+// required, and only set those that are not zero.
+
+// github.com/golang/protobuf
+
+var protoBufOptionalAConverter = &Converter{
+	New: func() interface{} { return &ProtoBufOptionalA{} },
+	From: func(dst, src interface{}) {
+		a := src.(*A)
+		o := dst.(*ProtoBufOptionalA)
+		*o = ProtoBufOptionalA{}
+		if a.Name != "" {
+			o.Name = proto.String(a.Name)
+		}
+		if t := a.BirthDay.UnixNano(); t != 0 {
+			o.BirthDay = proto.Int64(t)
+		}
+		if a.Phone != "" {
+			o.Phone = proto.String(a.Phone)
+		}
+		if a.Siblings != 0 {
+			o.Siblings = proto.Int32(int32(a.Siblings))
+		}
+		if a.Spouse {
+			o.Spouse = proto.Bool(a.Spouse)
+		}
+		if a.Money != 0 {
+			o.Money = proto.Float64(a.Money)
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*ProtoBufOptionalA)
+		*dst.(*A) = A{
+			Name:     o.GetName(),
+			BirthDay: time.Unix(0, o.GetBirthDay()),
+			Phone:    o.GetPhone(),
+			Siblings: int(o.GetSiblings()),
+			Spouse:   o.GetSpouse(),
+			Money:    o.GetMoney(),
+		}
+	},
+}
+
+// github.com/gogo/protobuf/proto
+
+var gogoProtoBufOptionalAConverter = &Converter{
+	New: func() interface{} { return &GogoProtoBufOptionalA{} },
+	From: func(dst, src interface{}) {
+		a := src.(*A)
+		o := dst.(*GogoProtoBufOptionalA)
+		*o = GogoProtoBufOptionalA{}
+		if a.Name != "" {
+			o.Name = proto.String(a.Name)
+		}
+		if t := a.BirthDay.UnixNano(); t != 0 {
+			o.BirthDay = proto.Int64(t)
+		}
+		if a.Phone != "" {
+			o.Phone = proto.String(a.Phone)
+		}
+		if a.Siblings != 0 {
+			o.Siblings = proto.Int32(int32(a.Siblings))
+		}
+		if a.Spouse {
+			o.Spouse = proto.Bool(a.Spouse)
+		}
+		if a.Money != 0 {
+			o.Money = proto.Float64(a.Money)
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*GogoProtoBufOptionalA)
+		*dst.(*A) = A{
+			Name:     o.GetName(),
+			BirthDay: time.Unix(0, o.GetBirthDay()),
+			Phone:    o.GetPhone(),
+			Siblings: int(o.GetSiblings()),
+			Spouse:   o.GetSpouse(),
+			Money:    o.GetMoney(),
+		}
+	},
+}
+
+// The Sparse payload is registered for every serializer of A, followed by the
+// variants that may omit empty fields.
+func init() {
+	RegisterPayload(payloadSparse, nil)
+	for _, info := range []SerializerInfo{
+		{
+			Name:          "JsonOmitEmpty",
+			Package:       "encoding/json",
+			New:           func() Serializer { return JsonSerializer{} },
+			GoroutineSafe: true,
+			Converter:     omitEmptyAConverter,
+		},
+		{
+			Name:          "JsonIterOmitEmpty",
+			Package:       "github.com/json-iterator/go",
+			New:           func() Serializer { return JsonIterSerializer{} },
+			GoroutineSafe: true,
+			Tolerance:     Tolerance{Float: 1e-6},
+			Converter:     omitEmptyAConverter,
+		},
+		{
+			Name:          "EasyJsonOmitEmpty",
+			Package:       "github.com/mailru/easyjson",
+			Generated:     true,
+			New:           func() Serializer { return EasyJSONSerializer{} },
+			GoroutineSafe: true,
+			Converter:     omitEmptyAConverter,
+		},
+		{
+			Name:          "BsonOmitEmpty",
+			Package:       "gopkg.in/mgo.v2/bson",
+			New:           func() Serializer { return BsonSerializer{} },
+			GoroutineSafe: true,
+			Tolerance:     Tolerance{Time: time.Millisecond},
+			Converter:     omitEmptyAConverter,
+		},
+		{
+			Name:          "GoprotobufOptional",
+			Package:       "github.com/golang/protobuf",
+			Generated:     true,
+			New:           func() Serializer { return GoprotobufSerializer{} },
+			GoroutineSafe: true,
+			Converter:     protoBufOptionalAConverter,
+		},
+		{
+			Name:          "GogoprotobufOptional",
+			Package:       "github.com/gogo/protobuf/proto",
+			Generated:     true,
+			New:           func() Serializer { return GogoprotobufSerializer{} },
+			GoroutineSafe: true,
+			Converter:     gogoProtoBufOptionalAConverter,
+		},
+	} {
+		info.Payload = payloadSparse
+		RegisterSerializer(info)
+	}
+}
@@ -0,0 +1,71 @@
+package goserbench
+
+import (
+	"math/rand"
+	"strings"
+)
+
+// stringProfile is a kind of text the Strings payloads write into the Name
+// and Phone of A, drawn from a set of pieces: characters, or sequences of
+// them shown as one.
+type stringProfile struct {
+	name   string
+	pieces []string
+}
+
+// stringProfiles are the texts randString never produces: every one of them
+// takes a slower path than lowercase hex through a JSON encoder, and the
+// multibyte ones through UTF-8 validation.
+var stringProfiles = []stringProfile{
+	// Accented Latin, Greek and Cyrillic take two bytes each, CJK and
+	// Hangul three.
+	{"UTF8", strings.Split("a e o n áéíóúñçøßæ λΩπ ЖщЯж 中文字日本語 한국어", "")},
+	// Emoji take four bytes, modified or joined ones up to 25.
+	{"Emoji", []string{"a", " ", "😀", "🚀", "🎉", "👍🏽", "🇳🇱", "❤️", "👩‍👩‍👧‍👦", "🧑🏻‍💻"}},
+	// Characters JSON escapes, as \", \\ or \u00XX, the HTML-sensitive
+	// ones and the line separators JavaScript rejects in strings, which
+	// encoding/json escapes by default, next to letters that need nothing.
+	{"Escape", []string{"a", "b", "c", `"`, `\`, "/", "<", ">", "&", "'", "\x00", "\t", "\n", "\r", "\x1b", "\x7f", "\u2028", "\u2029"}},
+}
+
+// randText joins n pieces of p picked at random.
+func randText(r *rand.Rand, p stringProfile, n int) string {
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteString(p.pieces[r.Intn(len(p.pieces))])
+	}
+	return b.String()
+}
+
+// generateStrings returns the records of payload A with the same number of
+// characters of profile p in Name and Phone as they hold hex digits there.
+func generateStrings(p stringProfile) []*A {
+	r := newRand()
+	records := payloadA.Records()
+	s := make([]*A, len(records))
+	for i, record := range records {
+		a := *record.(*A)
+		a.Name = randText(r, p, len(a.Name))
+		a.Phone = randText(r, p, len(a.Phone))
+		s[i] = &a
+	}
+	return s
+}
+
+// stringsPayload returns the payload of profile p, named after it, e.g.
+// StringsEmoji.
+func stringsPayload(p stringProfile) *Payload {
+	return &Payload{
+		Name:     "Strings" + p.name,
+		Generate: func() []interface{} { return interfaces(generateStrings(p)) },
+		New:      func() interface{} { return &A{} },
+	}
+}
+
+// The Strings payloads are registered for every serializer of A, one payload
+// per profile.
+func init() {
+	for _, profile := range stringProfiles {
+		RegisterPayload(stringsPayload(profile), nil)
+	}
+}
@@ -10,6 +10,22 @@
 
 	It has these top-level messages:
 		GogoProtoBufA
+		GogoProtoBufPerson
+		GogoProtoBufAddress
+		GogoProtoBufChild
+		GogoProtoBufTaggedA
+		GogoProtoBufBlob
+		GogoProtoBufBatch
+		GogoProtoBufSeries
+		GogoProtoBufOptionalA
+		GogoProtoBufEvent
+		GogoProtoBufClick
+		GogoProtoBufPurchase
+		GogoProtoBufSignup
+		GogoProtoBufNode
+		GogoProtoBufSeriesUnpacked
+		GogoProtoBufSeriesFixed
+		GogoProtoBufSeriesZigzag
 */
 package goserbench
 
@@ -86,107 +102,4322 @@ func (m *GogoProtoBufA) GetMoney() float64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*GogoProtoBufA)(nil), "goserbench.GogoProtoBufA")
+type GogoProtoBufPerson struct {
+	Name     string              `protobuf:"bytes,1,req,name=name" json:"name"`
+	BirthDay int64               `protobuf:"varint,2,req,name=birthDay" json:"birthDay"`
+	Phone    string              `protobuf:"bytes,3,req,name=phone" json:"phone"`
+	Money    float64             `protobuf:"fixed64,4,req,name=money" json:"money"`
+	Address  GogoProtoBufAddress `protobuf:"bytes,5,req,name=address" json:"address"`
+	Children []GogoProtoBufChild `protobuf:"bytes,6,rep,name=children" json:"children"`
 }
-func (m *GogoProtoBufA) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+
+func (m *GogoProtoBufPerson) Reset()                    { *m = GogoProtoBufPerson{} }
+func (m *GogoProtoBufPerson) String() string            { return proto.CompactTextString(m) }
+func (*GogoProtoBufPerson) ProtoMessage()               {}
+func (*GogoProtoBufPerson) Descriptor() ([]byte, []int) { return fileDescriptorStructdefGogo, []int{1} }
+
+func (m *GogoProtoBufPerson) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return data[:n], nil
+	return ""
 }
 
-func (m *GogoProtoBufA) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	data[i] = 0xa
-	i++
-	i = encodeVarintStructdefGogo(data, i, uint64(len(m.Name)))
-	i += copy(data[i:], m.Name)
-	data[i] = 0x10
-	i++
-	i = encodeVarintStructdefGogo(data, i, uint64(m.BirthDay))
-	data[i] = 0x1a
-	i++
-	i = encodeVarintStructdefGogo(data, i, uint64(len(m.Phone)))
-	i += copy(data[i:], m.Phone)
-	data[i] = 0x20
-	i++
-	i = encodeVarintStructdefGogo(data, i, uint64(m.Siblings))
-	data[i] = 0x28
-	i++
-	if m.Spouse {
-		data[i] = 1
-	} else {
-		data[i] = 0
+func (m *GogoProtoBufPerson) GetBirthDay() int64 {
+	if m != nil {
+		return m.BirthDay
 	}
-	i++
-	data[i] = 0x31
-	i++
-	i = encodeFixed64StructdefGogo(data, i, uint64(math.Float64bits(float64(m.Money))))
-	return i, nil
+	return 0
 }
 
-func encodeFixed64StructdefGogo(data []byte, offset int, v uint64) int {
-	data[offset] = uint8(v)
-	data[offset+1] = uint8(v >> 8)
-	data[offset+2] = uint8(v >> 16)
-	data[offset+3] = uint8(v >> 24)
-	data[offset+4] = uint8(v >> 32)
-	data[offset+5] = uint8(v >> 40)
-	data[offset+6] = uint8(v >> 48)
-	data[offset+7] = uint8(v >> 56)
-	return offset + 8
+func (m *GogoProtoBufPerson) GetPhone() string {
+	if m != nil {
+		return m.Phone
+	}
+	return ""
 }
-func encodeFixed32StructdefGogo(data []byte, offset int, v uint32) int {
-	data[offset] = uint8(v)
-	data[offset+1] = uint8(v >> 8)
-	data[offset+2] = uint8(v >> 16)
-	data[offset+3] = uint8(v >> 24)
-	return offset + 4
+
+func (m *GogoProtoBufPerson) GetMoney() float64 {
+	if m != nil {
+		return m.Money
+	}
+	return 0
 }
-func encodeVarintStructdefGogo(data []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		data[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+
+func (m *GogoProtoBufPerson) GetAddress() GogoProtoBufAddress {
+	if m != nil {
+		return m.Address
+	}
+	return GogoProtoBufAddress{}
+}
+
+func (m *GogoProtoBufPerson) GetChildren() []GogoProtoBufChild {
+	if m != nil {
+		return m.Children
+	}
+	return nil
+}
+
+type GogoProtoBufAddress struct {
+	Street  string `protobuf:"bytes,1,req,name=street" json:"street"`
+	City    string `protobuf:"bytes,2,req,name=city" json:"city"`
+	Zip     string `protobuf:"bytes,3,req,name=zip" json:"zip"`
+	Country string `protobuf:"bytes,4,req,name=country" json:"country"`
+}
+
+func (m *GogoProtoBufAddress) Reset()         { *m = GogoProtoBufAddress{} }
+func (m *GogoProtoBufAddress) String() string { return proto.CompactTextString(m) }
+func (*GogoProtoBufAddress) ProtoMessage()    {}
+func (*GogoProtoBufAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptorStructdefGogo, []int{2}
+}
+
+func (m *GogoProtoBufAddress) GetStreet() string {
+	if m != nil {
+		return m.Street
+	}
+	return ""
+}
+
+func (m *GogoProtoBufAddress) GetCity() string {
+	if m != nil {
+		return m.City
+	}
+	return ""
+}
+
+func (m *GogoProtoBufAddress) GetZip() string {
+	if m != nil {
+		return m.Zip
+	}
+	return ""
+}
+
+func (m *GogoProtoBufAddress) GetCountry() string {
+	if m != nil {
+		return m.Country
+	}
+	return ""
+}
+
+type GogoProtoBufChild struct {
+	Name     string `protobuf:"bytes,1,req,name=name" json:"name"`
+	BirthDay int64  `protobuf:"varint,2,req,name=birthDay" json:"birthDay"`
+	Grade    int32  `protobuf:"varint,3,req,name=grade" json:"grade"`
+}
+
+func (m *GogoProtoBufChild) Reset()                    { *m = GogoProtoBufChild{} }
+func (m *GogoProtoBufChild) String() string            { return proto.CompactTextString(m) }
+func (*GogoProtoBufChild) ProtoMessage()               {}
+func (*GogoProtoBufChild) Descriptor() ([]byte, []int) { return fileDescriptorStructdefGogo, []int{3} }
+
+func (m *GogoProtoBufChild) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GogoProtoBufChild) GetBirthDay() int64 {
+	if m != nil {
+		return m.BirthDay
+	}
+	return 0
+}
+
+func (m *GogoProtoBufChild) GetGrade() int32 {
+	if m != nil {
+		return m.Grade
+	}
+	return 0
+}
+
+type GogoProtoBufTaggedA struct {
+	Name     string            `protobuf:"bytes,1,req,name=name" json:"name"`
+	BirthDay int64             `protobuf:"varint,2,req,name=birthDay" json:"birthDay"`
+	Phone    string            `protobuf:"bytes,3,req,name=phone" json:"phone"`
+	Siblings int32             `protobuf:"varint,4,req,name=siblings" json:"siblings"`
+	Spouse   bool              `protobuf:"varint,5,req,name=spouse" json:"spouse"`
+	Money    float64           `protobuf:"fixed64,6,req,name=money" json:"money"`
+	Tags     map[string]string `protobuf:"bytes,7,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Aliases  []string          `protobuf:"bytes,8,rep,name=aliases" json:"aliases,omitempty"`
+}
+
+func (m *GogoProtoBufTaggedA) Reset()         { *m = GogoProtoBufTaggedA{} }
+func (m *GogoProtoBufTaggedA) String() string { return proto.CompactTextString(m) }
+func (*GogoProtoBufTaggedA) ProtoMessage()    {}
+func (*GogoProtoBufTaggedA) Descriptor() ([]byte, []int) {
+	return fileDescriptorStructdefGogo, []int{4}
+}
+
+func (m *GogoProtoBufTaggedA) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GogoProtoBufTaggedA) GetBirthDay() int64 {
+	if m != nil {
+		return m.BirthDay
+	}
+	return 0
+}
+
+func (m *GogoProtoBufTaggedA) GetPhone() string {
+	if m != nil {
+		return m.Phone
+	}
+	return ""
+}
+
+func (m *GogoProtoBufTaggedA) GetSiblings() int32 {
+	if m != nil {
+		return m.Siblings
+	}
+	return 0
+}
+
+func (m *GogoProtoBufTaggedA) GetSpouse() bool {
+	if m != nil {
+		return m.Spouse
+	}
+	return false
+}
+
+func (m *GogoProtoBufTaggedA) GetMoney() float64 {
+	if m != nil {
+		return m.Money
+	}
+	return 0
+}
+
+func (m *GogoProtoBufTaggedA) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *GogoProtoBufTaggedA) GetAliases() []string {
+	if m != nil {
+		return m.Aliases
+	}
+	return nil
+}
+
+type GogoProtoBufBlob struct {
+	Name string `protobuf:"bytes,1,req,name=name" json:"name"`
+	Data []byte `protobuf:"bytes,2,req,name=data" json:"data"`
+}
+
+func (m *GogoProtoBufBlob) Reset()                    { *m = GogoProtoBufBlob{} }
+func (m *GogoProtoBufBlob) String() string            { return proto.CompactTextString(m) }
+func (*GogoProtoBufBlob) ProtoMessage()               {}
+func (*GogoProtoBufBlob) Descriptor() ([]byte, []int) { return fileDescriptorStructdefGogo, []int{5} }
+
+func (m *GogoProtoBufBlob) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GogoProtoBufBlob) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type GogoProtoBufBatch struct {
+	Records []GogoProtoBufA `protobuf:"bytes,1,rep,name=records" json:"records"`
+}
+
+func (m *GogoProtoBufBatch) Reset()                    { *m = GogoProtoBufBatch{} }
+func (m *GogoProtoBufBatch) String() string            { return proto.CompactTextString(m) }
+func (*GogoProtoBufBatch) ProtoMessage()               {}
+func (*GogoProtoBufBatch) Descriptor() ([]byte, []int) { return fileDescriptorStructdefGogo, []int{6} }
+
+func (m *GogoProtoBufBatch) GetRecords() []GogoProtoBufA {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+type GogoProtoBufSeries struct {
+	Name       string    `protobuf:"bytes,1,req,name=name" json:"name"`
+	Timestamps []int64   `protobuf:"varint,2,rep,name=timestamps,packed" json:"timestamps,omitempty"`
+	Values     []float64 `protobuf:"fixed64,3,rep,name=values,packed" json:"values,omitempty"`
+}
+
+func (m *GogoProtoBufSeries) Reset()                    { *m = GogoProtoBufSeries{} }
+func (m *GogoProtoBufSeries) String() string            { return proto.CompactTextString(m) }
+func (*GogoProtoBufSeries) ProtoMessage()               {}
+func (*GogoProtoBufSeries) Descriptor() ([]byte, []int) { return fileDescriptorStructdefGogo, []int{7} }
+
+func (m *GogoProtoBufSeries) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GogoProtoBufSeries) GetTimestamps() []int64 {
+	if m != nil {
+		return m.Timestamps
+	}
+	return nil
+}
+
+func (m *GogoProtoBufSeries) GetValues() []float64 {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+type GogoProtoBufOptionalA struct {
+	Name     *string  `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	BirthDay *int64   `protobuf:"varint,2,opt,name=birthDay" json:"birthDay,omitempty"`
+	Phone    *string  `protobuf:"bytes,3,opt,name=phone" json:"phone,omitempty"`
+	Siblings *int32   `protobuf:"varint,4,opt,name=siblings" json:"siblings,omitempty"`
+	Spouse   *bool    `protobuf:"varint,5,opt,name=spouse" json:"spouse,omitempty"`
+	Money    *float64 `protobuf:"fixed64,6,opt,name=money" json:"money,omitempty"`
+}
+
+func (m *GogoProtoBufOptionalA) Reset()         { *m = GogoProtoBufOptionalA{} }
+func (m *GogoProtoBufOptionalA) String() string { return proto.CompactTextString(m) }
+func (*GogoProtoBufOptionalA) ProtoMessage()    {}
+func (*GogoProtoBufOptionalA) Descriptor() ([]byte, []int) {
+	return fileDescriptorStructdefGogo, []int{8}
+}
+
+func (m *GogoProtoBufOptionalA) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *GogoProtoBufOptionalA) GetBirthDay() int64 {
+	if m != nil && m.BirthDay != nil {
+		return *m.BirthDay
+	}
+	return 0
+}
+
+func (m *GogoProtoBufOptionalA) GetPhone() string {
+	if m != nil && m.Phone != nil {
+		return *m.Phone
+	}
+	return ""
+}
+
+func (m *GogoProtoBufOptionalA) GetSiblings() int32 {
+	if m != nil && m.Siblings != nil {
+		return *m.Siblings
+	}
+	return 0
+}
+
+func (m *GogoProtoBufOptionalA) GetSpouse() bool {
+	if m != nil && m.Spouse != nil {
+		return *m.Spouse
+	}
+	return false
+}
+
+func (m *GogoProtoBufOptionalA) GetMoney() float64 {
+	if m != nil && m.Money != nil {
+		return *m.Money
+	}
+	return 0
+}
+
+type GogoProtoBufEvent struct {
+	Id int64 `protobuf:"varint,1,req,name=id" json:"id"`
+	// Types that are valid to be assigned to Body:
+	//	*GogoProtoBufEvent_Click
+	//	*GogoProtoBufEvent_Purchase
+	//	*GogoProtoBufEvent_Signup
+	Body isGogoProtoBufEvent_Body `protobuf_oneof:"body"`
+}
+
+func (m *GogoProtoBufEvent) Reset()                    { *m = GogoProtoBufEvent{} }
+func (m *GogoProtoBufEvent) String() string            { return proto.CompactTextString(m) }
+func (*GogoProtoBufEvent) ProtoMessage()               {}
+func (*GogoProtoBufEvent) Descriptor() ([]byte, []int) { return fileDescriptorStructdefGogo, []int{9} }
+
+type isGogoProtoBufEvent_Body interface {
+	isGogoProtoBufEvent_Body()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type GogoProtoBufEvent_Click struct {
+	Click *GogoProtoBufClick `protobuf:"bytes,2,opt,name=click,oneof"`
+}
+type GogoProtoBufEvent_Purchase struct {
+	Purchase *GogoProtoBufPurchase `protobuf:"bytes,3,opt,name=purchase,oneof"`
+}
+type GogoProtoBufEvent_Signup struct {
+	Signup *GogoProtoBufSignup `protobuf:"bytes,4,opt,name=signup,oneof"`
+}
+
+func (*GogoProtoBufEvent_Click) isGogoProtoBufEvent_Body()    {}
+func (*GogoProtoBufEvent_Purchase) isGogoProtoBufEvent_Body() {}
+func (*GogoProtoBufEvent_Signup) isGogoProtoBufEvent_Body()   {}
+
+func (m *GogoProtoBufEvent) GetBody() isGogoProtoBufEvent_Body {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+func (m *GogoProtoBufEvent) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *GogoProtoBufEvent) GetClick() *GogoProtoBufClick {
+	if x, ok := m.GetBody().(*GogoProtoBufEvent_Click); ok {
+		return x.Click
+	}
+	return nil
+}
+
+func (m *GogoProtoBufEvent) GetPurchase() *GogoProtoBufPurchase {
+	if x, ok := m.GetBody().(*GogoProtoBufEvent_Purchase); ok {
+		return x.Purchase
+	}
+	return nil
+}
+
+func (m *GogoProtoBufEvent) GetSignup() *GogoProtoBufSignup {
+	if x, ok := m.GetBody().(*GogoProtoBufEvent_Signup); ok {
+		return x.Signup
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*GogoProtoBufEvent) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*GogoProtoBufEvent_Click)(nil),
+		(*GogoProtoBufEvent_Purchase)(nil),
+		(*GogoProtoBufEvent_Signup)(nil),
+	}
+}
+
+type GogoProtoBufClick struct {
+	Page string `protobuf:"bytes,1,req,name=page" json:"page"`
+	X    int32  `protobuf:"varint,2,req,name=x" json:"x"`
+	Y    int32  `protobuf:"varint,3,req,name=y" json:"y"`
+}
+
+func (m *GogoProtoBufClick) Reset()                    { *m = GogoProtoBufClick{} }
+func (m *GogoProtoBufClick) String() string            { return proto.CompactTextString(m) }
+func (*GogoProtoBufClick) ProtoMessage()               {}
+func (*GogoProtoBufClick) Descriptor() ([]byte, []int) { return fileDescriptorStructdefGogo, []int{10} }
+
+func (m *GogoProtoBufClick) GetPage() string {
+	if m != nil {
+		return m.Page
+	}
+	return ""
+}
+
+func (m *GogoProtoBufClick) GetX() int32 {
+	if m != nil {
+		return m.X
+	}
+	return 0
+}
+
+func (m *GogoProtoBufClick) GetY() int32 {
+	if m != nil {
+		return m.Y
+	}
+	return 0
+}
+
+type GogoProtoBufPurchase struct {
+	Item     string  `protobuf:"bytes,1,req,name=item" json:"item"`
+	Quantity int32   `protobuf:"varint,2,req,name=quantity" json:"quantity"`
+	Price    float64 `protobuf:"fixed64,3,req,name=price" json:"price"`
+}
+
+func (m *GogoProtoBufPurchase) Reset()         { *m = GogoProtoBufPurchase{} }
+func (m *GogoProtoBufPurchase) String() string { return proto.CompactTextString(m) }
+func (*GogoProtoBufPurchase) ProtoMessage()    {}
+func (*GogoProtoBufPurchase) Descriptor() ([]byte, []int) {
+	return fileDescriptorStructdefGogo, []int{11}
+}
+
+func (m *GogoProtoBufPurchase) GetItem() string {
+	if m != nil {
+		return m.Item
+	}
+	return ""
+}
+
+func (m *GogoProtoBufPurchase) GetQuantity() int32 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *GogoProtoBufPurchase) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+type GogoProtoBufSignup struct {
+	Name     string `protobuf:"bytes,1,req,name=name" json:"name"`
+	Email    string `protobuf:"bytes,2,req,name=email" json:"email"`
+	Referrer string `protobuf:"bytes,3,req,name=referrer" json:"referrer"`
+}
+
+func (m *GogoProtoBufSignup) Reset()         { *m = GogoProtoBufSignup{} }
+func (m *GogoProtoBufSignup) String() string { return proto.CompactTextString(m) }
+func (*GogoProtoBufSignup) ProtoMessage()    {}
+func (*GogoProtoBufSignup) Descriptor() ([]byte, []int) {
+	return fileDescriptorStructdefGogo, []int{12}
+}
+
+func (m *GogoProtoBufSignup) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GogoProtoBufSignup) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *GogoProtoBufSignup) GetReferrer() string {
+	if m != nil {
+		return m.Referrer
+	}
+	return ""
+}
+
+type GogoProtoBufNode struct {
+	Name     string             `protobuf:"bytes,1,req,name=name" json:"name"`
+	Value    int64              `protobuf:"varint,2,req,name=value" json:"value"`
+	Children []GogoProtoBufNode `protobuf:"bytes,3,rep,name=children" json:"children"`
+}
+
+func (m *GogoProtoBufNode) Reset()                    { *m = GogoProtoBufNode{} }
+func (m *GogoProtoBufNode) String() string            { return proto.CompactTextString(m) }
+func (*GogoProtoBufNode) ProtoMessage()               {}
+func (*GogoProtoBufNode) Descriptor() ([]byte, []int) { return fileDescriptorStructdefGogo, []int{13} }
+
+func (m *GogoProtoBufNode) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GogoProtoBufNode) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *GogoProtoBufNode) GetChildren() []GogoProtoBufNode {
+	if m != nil {
+		return m.Children
+	}
+	return nil
+}
+
+type GogoProtoBufSeriesUnpacked struct {
+	Name       string    `protobuf:"bytes,1,req,name=name" json:"name"`
+	Timestamps []int64   `protobuf:"varint,2,rep,name=timestamps" json:"timestamps,omitempty"`
+	Values     []float64 `protobuf:"fixed64,3,rep,name=values" json:"values,omitempty"`
+}
+
+func (m *GogoProtoBufSeriesUnpacked) Reset()         { *m = GogoProtoBufSeriesUnpacked{} }
+func (m *GogoProtoBufSeriesUnpacked) String() string { return proto.CompactTextString(m) }
+func (*GogoProtoBufSeriesUnpacked) ProtoMessage()    {}
+func (*GogoProtoBufSeriesUnpacked) Descriptor() ([]byte, []int) {
+	return fileDescriptorStructdefGogo, []int{14}
+}
+
+func (m *GogoProtoBufSeriesUnpacked) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GogoProtoBufSeriesUnpacked) GetTimestamps() []int64 {
+	if m != nil {
+		return m.Timestamps
+	}
+	return nil
+}
+
+func (m *GogoProtoBufSeriesUnpacked) GetValues() []float64 {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+type GogoProtoBufSeriesFixed struct {
+	Name       string    `protobuf:"bytes,1,req,name=name" json:"name"`
+	Timestamps []int64   `protobuf:"fixed64,2,rep,name=timestamps,packed" json:"timestamps,omitempty"`
+	Values     []float64 `protobuf:"fixed64,3,rep,name=values,packed" json:"values,omitempty"`
+}
+
+func (m *GogoProtoBufSeriesFixed) Reset()         { *m = GogoProtoBufSeriesFixed{} }
+func (m *GogoProtoBufSeriesFixed) String() string { return proto.CompactTextString(m) }
+func (*GogoProtoBufSeriesFixed) ProtoMessage()    {}
+func (*GogoProtoBufSeriesFixed) Descriptor() ([]byte, []int) {
+	return fileDescriptorStructdefGogo, []int{15}
+}
+
+func (m *GogoProtoBufSeriesFixed) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GogoProtoBufSeriesFixed) GetTimestamps() []int64 {
+	if m != nil {
+		return m.Timestamps
+	}
+	return nil
+}
+
+func (m *GogoProtoBufSeriesFixed) GetValues() []float64 {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+type GogoProtoBufSeriesZigzag struct {
+	Name       string    `protobuf:"bytes,1,req,name=name" json:"name"`
+	Timestamps []int64   `protobuf:"zigzag64,2,rep,name=timestamps,packed" json:"timestamps,omitempty"`
+	Values     []float64 `protobuf:"fixed64,3,rep,name=values,packed" json:"values,omitempty"`
+}
+
+func (m *GogoProtoBufSeriesZigzag) Reset()         { *m = GogoProtoBufSeriesZigzag{} }
+func (m *GogoProtoBufSeriesZigzag) String() string { return proto.CompactTextString(m) }
+func (*GogoProtoBufSeriesZigzag) ProtoMessage()    {}
+func (*GogoProtoBufSeriesZigzag) Descriptor() ([]byte, []int) {
+	return fileDescriptorStructdefGogo, []int{16}
+}
+
+func (m *GogoProtoBufSeriesZigzag) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GogoProtoBufSeriesZigzag) GetTimestamps() []int64 {
+	if m != nil {
+		return m.Timestamps
+	}
+	return nil
+}
+
+func (m *GogoProtoBufSeriesZigzag) GetValues() []float64 {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GogoProtoBufA)(nil), "goserbench.GogoProtoBufA")
+	proto.RegisterType((*GogoProtoBufPerson)(nil), "goserbench.GogoProtoBufPerson")
+	proto.RegisterType((*GogoProtoBufAddress)(nil), "goserbench.GogoProtoBufAddress")
+	proto.RegisterType((*GogoProtoBufChild)(nil), "goserbench.GogoProtoBufChild")
+	proto.RegisterType((*GogoProtoBufTaggedA)(nil), "goserbench.GogoProtoBufTaggedA")
+	proto.RegisterType((*GogoProtoBufBlob)(nil), "goserbench.GogoProtoBufBlob")
+	proto.RegisterType((*GogoProtoBufBatch)(nil), "goserbench.GogoProtoBufBatch")
+	proto.RegisterType((*GogoProtoBufSeries)(nil), "goserbench.GogoProtoBufSeries")
+	proto.RegisterType((*GogoProtoBufOptionalA)(nil), "goserbench.GogoProtoBufOptionalA")
+	proto.RegisterType((*GogoProtoBufEvent)(nil), "goserbench.GogoProtoBufEvent")
+	proto.RegisterType((*GogoProtoBufClick)(nil), "goserbench.GogoProtoBufClick")
+	proto.RegisterType((*GogoProtoBufPurchase)(nil), "goserbench.GogoProtoBufPurchase")
+	proto.RegisterType((*GogoProtoBufSignup)(nil), "goserbench.GogoProtoBufSignup")
+	proto.RegisterType((*GogoProtoBufNode)(nil), "goserbench.GogoProtoBufNode")
+	proto.RegisterType((*GogoProtoBufSeriesUnpacked)(nil), "goserbench.GogoProtoBufSeriesUnpacked")
+	proto.RegisterType((*GogoProtoBufSeriesFixed)(nil), "goserbench.GogoProtoBufSeriesFixed")
+	proto.RegisterType((*GogoProtoBufSeriesZigzag)(nil), "goserbench.GogoProtoBufSeriesZigzag")
+}
+func (m *GogoProtoBufA) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *GogoProtoBufA) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(len(m.Name)))
+	i += copy(data[i:], m.Name)
+	data[i] = 0x10
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(m.BirthDay))
+	data[i] = 0x1a
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(len(m.Phone)))
+	i += copy(data[i:], m.Phone)
+	data[i] = 0x20
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(m.Siblings))
+	data[i] = 0x28
+	i++
+	if m.Spouse {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
+	data[i] = 0x31
+	i++
+	i = encodeFixed64StructdefGogo(data, i, uint64(math.Float64bits(float64(m.Money))))
+	return i, nil
+}
+
+func (m *GogoProtoBufPerson) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *GogoProtoBufPerson) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(len(m.Name)))
+	i += copy(data[i:], m.Name)
+	data[i] = 0x10
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(m.BirthDay))
+	data[i] = 0x1a
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(len(m.Phone)))
+	i += copy(data[i:], m.Phone)
+	data[i] = 0x21
+	i++
+	i = encodeFixed64StructdefGogo(data, i, uint64(math.Float64bits(float64(m.Money))))
+	data[i] = 0x2a
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(m.Address.Size()))
+	n1, err := m.Address.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
+	if len(m.Children) > 0 {
+		for _, msg := range m.Children {
+			data[i] = 0x32
+			i++
+			i = encodeVarintStructdefGogo(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *GogoProtoBufAddress) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *GogoProtoBufAddress) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(len(m.Street)))
+	i += copy(data[i:], m.Street)
+	data[i] = 0x12
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(len(m.City)))
+	i += copy(data[i:], m.City)
+	data[i] = 0x1a
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(len(m.Zip)))
+	i += copy(data[i:], m.Zip)
+	data[i] = 0x22
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(len(m.Country)))
+	i += copy(data[i:], m.Country)
+	return i, nil
+}
+
+func (m *GogoProtoBufChild) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *GogoProtoBufChild) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(len(m.Name)))
+	i += copy(data[i:], m.Name)
+	data[i] = 0x10
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(m.BirthDay))
+	data[i] = 0x18
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(m.Grade))
+	return i, nil
+}
+
+func (m *GogoProtoBufTaggedA) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *GogoProtoBufTaggedA) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(len(m.Name)))
+	i += copy(data[i:], m.Name)
+	data[i] = 0x10
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(m.BirthDay))
+	data[i] = 0x1a
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(len(m.Phone)))
+	i += copy(data[i:], m.Phone)
+	data[i] = 0x20
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(m.Siblings))
+	data[i] = 0x28
+	i++
+	if m.Spouse {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
+	data[i] = 0x31
+	i++
+	i = encodeFixed64StructdefGogo(data, i, uint64(math.Float64bits(float64(m.Money))))
+	if len(m.Tags) > 0 {
+		for k, _ := range m.Tags {
+			data[i] = 0x3a
+			i++
+			v := m.Tags[k]
+			mapSize := 1 + len(k) + sovStructdefGogo(uint64(len(k))) + 1 + len(v) + sovStructdefGogo(uint64(len(v)))
+			i = encodeVarintStructdefGogo(data, i, uint64(mapSize))
+			data[i] = 0xa
+			i++
+			i = encodeVarintStructdefGogo(data, i, uint64(len(k)))
+			i += copy(data[i:], k)
+			data[i] = 0x12
+			i++
+			i = encodeVarintStructdefGogo(data, i, uint64(len(v)))
+			i += copy(data[i:], v)
+		}
+	}
+	if len(m.Aliases) > 0 {
+		for _, s := range m.Aliases {
+			data[i] = 0x42
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *GogoProtoBufBlob) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *GogoProtoBufBlob) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(len(m.Name)))
+	i += copy(data[i:], m.Name)
+	data[i] = 0x12
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(len(m.Data)))
+	i += copy(data[i:], m.Data)
+	return i, nil
+}
+
+func (m *GogoProtoBufBatch) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *GogoProtoBufBatch) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, msg := range m.Records {
+			data[i] = 0xa
+			i++
+			i = encodeVarintStructdefGogo(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *GogoProtoBufSeries) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *GogoProtoBufSeries) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(len(m.Name)))
+	i += copy(data[i:], m.Name)
+	if len(m.Timestamps) > 0 {
+		data2 := make([]byte, len(m.Timestamps)*10)
+		var j1 int
+		for _, num1 := range m.Timestamps {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				data2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			data2[j1] = uint8(num)
+			j1++
+		}
+		data[i] = 0x12
+		i++
+		i = encodeVarintStructdefGogo(data, i, uint64(j1))
+		i += copy(data[i:], data2[:j1])
+	}
+	if len(m.Values) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintStructdefGogo(data, i, uint64(len(m.Values)*8))
+		for _, num := range m.Values {
+			i = encodeFixed64StructdefGogo(data, i, uint64(math.Float64bits(float64(num))))
+		}
+	}
+	return i, nil
+}
+
+func (m *GogoProtoBufOptionalA) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *GogoProtoBufOptionalA) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Name != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintStructdefGogo(data, i, uint64(len(*m.Name)))
+		i += copy(data[i:], *m.Name)
+	}
+	if m.BirthDay != nil {
+		data[i] = 0x10
+		i++
+		i = encodeVarintStructdefGogo(data, i, uint64(*m.BirthDay))
+	}
+	if m.Phone != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintStructdefGogo(data, i, uint64(len(*m.Phone)))
+		i += copy(data[i:], *m.Phone)
+	}
+	if m.Siblings != nil {
+		data[i] = 0x20
+		i++
+		i = encodeVarintStructdefGogo(data, i, uint64(*m.Siblings))
+	}
+	if m.Spouse != nil {
+		data[i] = 0x28
+		i++
+		if *m.Spouse {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.Money != nil {
+		data[i] = 0x31
+		i++
+		i = encodeFixed64StructdefGogo(data, i, uint64(math.Float64bits(float64(*m.Money))))
+	}
+	return i, nil
+}
+
+func (m *GogoProtoBufEvent) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *GogoProtoBufEvent) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(m.Id))
+	if m.Body != nil {
+		nn1, err := m.Body.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn1
+	}
+	return i, nil
+}
+
+func (m *GogoProtoBufEvent_Click) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.Click != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintStructdefGogo(data, i, uint64(m.Click.Size()))
+		n2, err := m.Click.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	return i, nil
+}
+func (m *GogoProtoBufEvent_Purchase) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.Purchase != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintStructdefGogo(data, i, uint64(m.Purchase.Size()))
+		n3, err := m.Purchase.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	return i, nil
+}
+func (m *GogoProtoBufEvent_Signup) MarshalTo(data []byte) (int, error) {
+	i := 0
+	if m.Signup != nil {
+		data[i] = 0x22
+		i++
+		i = encodeVarintStructdefGogo(data, i, uint64(m.Signup.Size()))
+		n4, err := m.Signup.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	return i, nil
+}
+func (m *GogoProtoBufClick) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *GogoProtoBufClick) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(len(m.Page)))
+	i += copy(data[i:], m.Page)
+	data[i] = 0x10
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(m.X))
+	data[i] = 0x18
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(m.Y))
+	return i, nil
+}
+
+func (m *GogoProtoBufPurchase) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *GogoProtoBufPurchase) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(len(m.Item)))
+	i += copy(data[i:], m.Item)
+	data[i] = 0x10
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(m.Quantity))
+	data[i] = 0x19
+	i++
+	i = encodeFixed64StructdefGogo(data, i, uint64(math.Float64bits(float64(m.Price))))
+	return i, nil
+}
+
+func (m *GogoProtoBufSignup) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *GogoProtoBufSignup) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(len(m.Name)))
+	i += copy(data[i:], m.Name)
+	data[i] = 0x12
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(len(m.Email)))
+	i += copy(data[i:], m.Email)
+	data[i] = 0x1a
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(len(m.Referrer)))
+	i += copy(data[i:], m.Referrer)
+	return i, nil
+}
+
+func (m *GogoProtoBufNode) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *GogoProtoBufNode) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(len(m.Name)))
+	i += copy(data[i:], m.Name)
+	data[i] = 0x10
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(m.Value))
+	if len(m.Children) > 0 {
+		for _, msg := range m.Children {
+			data[i] = 0x1a
+			i++
+			i = encodeVarintStructdefGogo(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *GogoProtoBufSeriesUnpacked) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *GogoProtoBufSeriesUnpacked) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(len(m.Name)))
+	i += copy(data[i:], m.Name)
+	if len(m.Timestamps) > 0 {
+		for _, num := range m.Timestamps {
+			data[i] = 0x10
+			i++
+			i = encodeVarintStructdefGogo(data, i, uint64(num))
+		}
+	}
+	if len(m.Values) > 0 {
+		for _, num := range m.Values {
+			data[i] = 0x19
+			i++
+			i = encodeFixed64StructdefGogo(data, i, uint64(math.Float64bits(float64(num))))
+		}
+	}
+	return i, nil
+}
+
+func (m *GogoProtoBufSeriesFixed) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *GogoProtoBufSeriesFixed) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(len(m.Name)))
+	i += copy(data[i:], m.Name)
+	if len(m.Timestamps) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintStructdefGogo(data, i, uint64(len(m.Timestamps)*8))
+		for _, num := range m.Timestamps {
+			i = encodeFixed64StructdefGogo(data, i, uint64(num))
+		}
+	}
+	if len(m.Values) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintStructdefGogo(data, i, uint64(len(m.Values)*8))
+		for _, num := range m.Values {
+			i = encodeFixed64StructdefGogo(data, i, uint64(math.Float64bits(float64(num))))
+		}
+	}
+	return i, nil
+}
+
+func (m *GogoProtoBufSeriesZigzag) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *GogoProtoBufSeriesZigzag) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructdefGogo(data, i, uint64(len(m.Name)))
+	i += copy(data[i:], m.Name)
+	if len(m.Timestamps) > 0 {
+		data2 := make([]byte, len(m.Timestamps)*10)
+		var j1 int
+		for _, num1 := range m.Timestamps {
+			num := (uint64(num1) << 1) ^ uint64((num1 >> 63))
+			for num >= 1<<7 {
+				data2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			data2[j1] = uint8(num)
+			j1++
+		}
+		data[i] = 0x12
+		i++
+		i = encodeVarintStructdefGogo(data, i, uint64(j1))
+		i += copy(data[i:], data2[:j1])
+	}
+	if len(m.Values) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintStructdefGogo(data, i, uint64(len(m.Values)*8))
+		for _, num := range m.Values {
+			i = encodeFixed64StructdefGogo(data, i, uint64(math.Float64bits(float64(num))))
+		}
+	}
+	return i, nil
+}
+
+func encodeFixed64StructdefGogo(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32StructdefGogo(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintStructdefGogo(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *GogoProtoBufA) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovStructdefGogo(uint64(l))
+	n += 1 + sovStructdefGogo(uint64(m.BirthDay))
+	l = len(m.Phone)
+	n += 1 + l + sovStructdefGogo(uint64(l))
+	n += 1 + sovStructdefGogo(uint64(m.Siblings))
+	n += 2
+	n += 9
+	return n
+}
+
+func (m *GogoProtoBufPerson) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovStructdefGogo(uint64(l))
+	n += 1 + sovStructdefGogo(uint64(m.BirthDay))
+	l = len(m.Phone)
+	n += 1 + l + sovStructdefGogo(uint64(l))
+	n += 9
+	l = m.Address.Size()
+	n += 1 + l + sovStructdefGogo(uint64(l))
+	if len(m.Children) > 0 {
+		for _, e := range m.Children {
+			l = e.Size()
+			n += 1 + l + sovStructdefGogo(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GogoProtoBufAddress) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Street)
+	n += 1 + l + sovStructdefGogo(uint64(l))
+	l = len(m.City)
+	n += 1 + l + sovStructdefGogo(uint64(l))
+	l = len(m.Zip)
+	n += 1 + l + sovStructdefGogo(uint64(l))
+	l = len(m.Country)
+	n += 1 + l + sovStructdefGogo(uint64(l))
+	return n
+}
+
+func (m *GogoProtoBufChild) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovStructdefGogo(uint64(l))
+	n += 1 + sovStructdefGogo(uint64(m.BirthDay))
+	n += 1 + sovStructdefGogo(uint64(m.Grade))
+	return n
+}
+
+func (m *GogoProtoBufTaggedA) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovStructdefGogo(uint64(l))
+	n += 1 + sovStructdefGogo(uint64(m.BirthDay))
+	l = len(m.Phone)
+	n += 1 + l + sovStructdefGogo(uint64(l))
+	n += 1 + sovStructdefGogo(uint64(m.Siblings))
+	n += 2
+	n += 9
+	if len(m.Tags) > 0 {
+		for k, v := range m.Tags {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovStructdefGogo(uint64(len(k))) + 1 + len(v) + sovStructdefGogo(uint64(len(v)))
+			n += mapEntrySize + 1 + sovStructdefGogo(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Aliases) > 0 {
+		for _, s := range m.Aliases {
+			l = len(s)
+			n += 1 + l + sovStructdefGogo(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GogoProtoBufBlob) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovStructdefGogo(uint64(l))
+	l = len(m.Data)
+	n += 1 + l + sovStructdefGogo(uint64(l))
+	return n
+}
+
+func (m *GogoProtoBufBatch) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovStructdefGogo(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GogoProtoBufSeries) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovStructdefGogo(uint64(l))
+	if len(m.Timestamps) > 0 {
+		l = 0
+		for _, e := range m.Timestamps {
+			l += sovStructdefGogo(uint64(e))
+		}
+		n += 1 + sovStructdefGogo(uint64(l)) + l
+	}
+	if len(m.Values) > 0 {
+		n += 1 + sovStructdefGogo(uint64(len(m.Values)*8)) + len(m.Values)*8
+	}
+	return n
+}
+
+func (m *GogoProtoBufOptionalA) Size() (n int) {
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovStructdefGogo(uint64(l))
+	}
+	if m.BirthDay != nil {
+		n += 1 + sovStructdefGogo(uint64(*m.BirthDay))
+	}
+	if m.Phone != nil {
+		l = len(*m.Phone)
+		n += 1 + l + sovStructdefGogo(uint64(l))
+	}
+	if m.Siblings != nil {
+		n += 1 + sovStructdefGogo(uint64(*m.Siblings))
+	}
+	if m.Spouse != nil {
+		n += 2
+	}
+	if m.Money != nil {
+		n += 9
+	}
+	return n
+}
+
+func (m *GogoProtoBufEvent) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovStructdefGogo(uint64(m.Id))
+	if m.Body != nil {
+		n += m.Body.Size()
+	}
+	return n
+}
+
+func (m *GogoProtoBufEvent_Click) Size() (n int) {
+	var l int
+	_ = l
+	if m.Click != nil {
+		l = m.Click.Size()
+		n += 1 + l + sovStructdefGogo(uint64(l))
+	}
+	return n
+}
+
+func (m *GogoProtoBufEvent_Purchase) Size() (n int) {
+	var l int
+	_ = l
+	if m.Purchase != nil {
+		l = m.Purchase.Size()
+		n += 1 + l + sovStructdefGogo(uint64(l))
+	}
+	return n
+}
+
+func (m *GogoProtoBufEvent_Signup) Size() (n int) {
+	var l int
+	_ = l
+	if m.Signup != nil {
+		l = m.Signup.Size()
+		n += 1 + l + sovStructdefGogo(uint64(l))
+	}
+	return n
+}
+
+func (m *GogoProtoBufClick) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Page)
+	n += 1 + l + sovStructdefGogo(uint64(l))
+	n += 1 + sovStructdefGogo(uint64(m.X))
+	n += 1 + sovStructdefGogo(uint64(m.Y))
+	return n
+}
+
+func (m *GogoProtoBufPurchase) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Item)
+	n += 1 + l + sovStructdefGogo(uint64(l))
+	n += 1 + sovStructdefGogo(uint64(m.Quantity))
+	n += 9
+	return n
+}
+
+func (m *GogoProtoBufSignup) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovStructdefGogo(uint64(l))
+	l = len(m.Email)
+	n += 1 + l + sovStructdefGogo(uint64(l))
+	l = len(m.Referrer)
+	n += 1 + l + sovStructdefGogo(uint64(l))
+	return n
+}
+
+func (m *GogoProtoBufNode) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovStructdefGogo(uint64(l))
+	n += 1 + sovStructdefGogo(uint64(m.Value))
+	if len(m.Children) > 0 {
+		for _, e := range m.Children {
+			l = e.Size()
+			n += 1 + l + sovStructdefGogo(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GogoProtoBufSeriesUnpacked) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovStructdefGogo(uint64(l))
+	if len(m.Timestamps) > 0 {
+		for _, e := range m.Timestamps {
+			n += 1 + sovStructdefGogo(uint64(e))
+		}
+	}
+	if len(m.Values) > 0 {
+		n += 9 * len(m.Values)
+	}
+	return n
+}
+
+func (m *GogoProtoBufSeriesFixed) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovStructdefGogo(uint64(l))
+	if len(m.Timestamps) > 0 {
+		n += 1 + sovStructdefGogo(uint64(len(m.Timestamps)*8)) + len(m.Timestamps)*8
+	}
+	if len(m.Values) > 0 {
+		n += 1 + sovStructdefGogo(uint64(len(m.Values)*8)) + len(m.Values)*8
+	}
+	return n
+}
+
+func (m *GogoProtoBufSeriesZigzag) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovStructdefGogo(uint64(l))
+	if len(m.Timestamps) > 0 {
+		l = 0
+		for _, e := range m.Timestamps {
+			l += sozStructdefGogo(uint64(e))
+		}
+		n += 1 + sovStructdefGogo(uint64(l)) + l
+	}
+	if len(m.Values) > 0 {
+		n += 1 + sovStructdefGogo(uint64(len(m.Values)*8)) + len(m.Values)*8
+	}
+	return n
+}
+
+func sovStructdefGogo(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozStructdefGogo(x uint64) (n int) {
+	return sovStructdefGogo(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GogoProtoBufA) Unmarshal(data []byte) error {
+	var hasFields [1]uint64
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructdefGogo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GogoProtoBufA: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GogoProtoBufA: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BirthDay", wireType)
+			}
+			m.BirthDay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.BirthDay |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phone = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Siblings", wireType)
+			}
+			m.Siblings = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Siblings |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			hasFields[0] |= uint64(0x00000008)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spouse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Spouse = bool(v != 0)
+			hasFields[0] |= uint64(0x00000010)
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Money", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(data[iNdEx-8])
+			v |= uint64(data[iNdEx-7]) << 8
+			v |= uint64(data[iNdEx-6]) << 16
+			v |= uint64(data[iNdEx-5]) << 24
+			v |= uint64(data[iNdEx-4]) << 32
+			v |= uint64(data[iNdEx-3]) << 40
+			v |= uint64(data[iNdEx-2]) << 48
+			v |= uint64(data[iNdEx-1]) << 56
+			m.Money = float64(math.Float64frombits(v))
+			hasFields[0] |= uint64(0x00000020)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructdefGogo(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("birthDay")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("phone")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("siblings")
+	}
+	if hasFields[0]&uint64(0x00000010) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("spouse")
+	}
+	if hasFields[0]&uint64(0x00000020) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("money")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GogoProtoBufPerson) Unmarshal(data []byte) error {
+	var hasFields [1]uint64
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructdefGogo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GogoProtoBufPerson: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GogoProtoBufPerson: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BirthDay", wireType)
+			}
+			m.BirthDay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.BirthDay |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phone = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Money", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(data[iNdEx-8])
+			v |= uint64(data[iNdEx-7]) << 8
+			v |= uint64(data[iNdEx-6]) << 16
+			v |= uint64(data[iNdEx-5]) << 24
+			v |= uint64(data[iNdEx-4]) << 32
+			v |= uint64(data[iNdEx-3]) << 40
+			v |= uint64(data[iNdEx-2]) << 48
+			v |= uint64(data[iNdEx-1]) << 56
+			m.Money = float64(math.Float64frombits(v))
+			hasFields[0] |= uint64(0x00000008)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000010)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Children = append(m.Children, GogoProtoBufChild{})
+			if err := m.Children[len(m.Children)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructdefGogo(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("birthDay")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("phone")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("money")
+	}
+	if hasFields[0]&uint64(0x00000010) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("address")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GogoProtoBufAddress) Unmarshal(data []byte) error {
+	var hasFields [1]uint64
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructdefGogo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GogoProtoBufAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GogoProtoBufAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Street", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Street = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field City", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.City = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zip", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zip = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Country = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000008)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructdefGogo(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("street")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("city")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("zip")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("country")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GogoProtoBufChild) Unmarshal(data []byte) error {
+	var hasFields [1]uint64
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructdefGogo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GogoProtoBufChild: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GogoProtoBufChild: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BirthDay", wireType)
+			}
+			m.BirthDay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.BirthDay |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grade", wireType)
+			}
+			m.Grade = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Grade |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			hasFields[0] |= uint64(0x00000004)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructdefGogo(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("birthDay")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("grade")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GogoProtoBufTaggedA) Unmarshal(data []byte) error {
+	var hasFields [1]uint64
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructdefGogo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GogoProtoBufTaggedA: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GogoProtoBufTaggedA: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BirthDay", wireType)
+			}
+			m.BirthDay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.BirthDay |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phone = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Siblings", wireType)
+			}
+			m.Siblings = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Siblings |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			hasFields[0] |= uint64(0x00000008)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spouse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Spouse = bool(v != 0)
+			hasFields[0] |= uint64(0x00000010)
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Money", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(data[iNdEx-8])
+			v |= uint64(data[iNdEx-7]) << 8
+			v |= uint64(data[iNdEx-6]) << 16
+			v |= uint64(data[iNdEx-5]) << 24
+			v |= uint64(data[iNdEx-4]) << 32
+			v |= uint64(data[iNdEx-3]) << 40
+			v |= uint64(data[iNdEx-2]) << 48
+			v |= uint64(data[iNdEx-1]) << 56
+			m.Money = float64(math.Float64frombits(v))
+			hasFields[0] |= uint64(0x00000020)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(data[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			var valuekey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				valuekey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapvalue uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapvalue := int(stringLenmapvalue)
+			if intStringLenmapvalue < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+			if postStringIndexmapvalue > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapvalue := string(data[iNdEx:postStringIndexmapvalue])
+			iNdEx = postStringIndexmapvalue
+			if m.Tags == nil {
+				m.Tags = make(map[string]string)
+			}
+			m.Tags[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aliases = append(m.Aliases, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructdefGogo(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("birthDay")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("phone")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("siblings")
+	}
+	if hasFields[0]&uint64(0x00000010) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("spouse")
+	}
+	if hasFields[0]&uint64(0x00000020) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("money")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GogoProtoBufBlob) Unmarshal(data []byte) error {
+	var hasFields [1]uint64
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructdefGogo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GogoProtoBufBlob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GogoProtoBufBlob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], data[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructdefGogo(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("data")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GogoProtoBufBatch) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructdefGogo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GogoProtoBufBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GogoProtoBufBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, GogoProtoBufA{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructdefGogo(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GogoProtoBufSeries) Unmarshal(data []byte) error {
+	var hasFields [1]uint64
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructdefGogo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GogoProtoBufSeries: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GogoProtoBufSeries: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStructdefGogo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStructdefGogo
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStructdefGogo
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Timestamps = append(m.Timestamps, v)
+				}
+			} else if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStructdefGogo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Timestamps = append(m.Timestamps, v)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamps", wireType)
+			}
+		case 3:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStructdefGogo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStructdefGogo
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += 8
+					v = uint64(data[iNdEx-8])
+					v |= uint64(data[iNdEx-7]) << 8
+					v |= uint64(data[iNdEx-6]) << 16
+					v |= uint64(data[iNdEx-5]) << 24
+					v |= uint64(data[iNdEx-4]) << 32
+					v |= uint64(data[iNdEx-3]) << 40
+					v |= uint64(data[iNdEx-2]) << 48
+					v |= uint64(data[iNdEx-1]) << 56
+					v2 := float64(math.Float64frombits(v))
+					m.Values = append(m.Values, v2)
+				}
+			} else if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx += 8
+				v = uint64(data[iNdEx-8])
+				v |= uint64(data[iNdEx-7]) << 8
+				v |= uint64(data[iNdEx-6]) << 16
+				v |= uint64(data[iNdEx-5]) << 24
+				v |= uint64(data[iNdEx-4]) << 32
+				v |= uint64(data[iNdEx-3]) << 40
+				v |= uint64(data[iNdEx-2]) << 48
+				v |= uint64(data[iNdEx-1]) << 56
+				v2 := float64(math.Float64frombits(v))
+				m.Values = append(m.Values, v2)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructdefGogo(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GogoProtoBufOptionalA) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructdefGogo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GogoProtoBufOptionalA: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GogoProtoBufOptionalA: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BirthDay", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BirthDay = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Phone = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Siblings", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Siblings = &v
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spouse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Spouse = &b
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Money", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(data[iNdEx-8])
+			v |= uint64(data[iNdEx-7]) << 8
+			v |= uint64(data[iNdEx-6]) << 16
+			v |= uint64(data[iNdEx-5]) << 24
+			v |= uint64(data[iNdEx-4]) << 32
+			v |= uint64(data[iNdEx-3]) << 40
+			v |= uint64(data[iNdEx-2]) << 48
+			v |= uint64(data[iNdEx-1]) << 56
+			v2 := float64(math.Float64frombits(v))
+			m.Money = &v2
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructdefGogo(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GogoProtoBufEvent) Unmarshal(data []byte) error {
+	var hasFields [1]uint64
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructdefGogo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GogoProtoBufEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GogoProtoBufEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Id |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Click", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GogoProtoBufClick{}
+			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &GogoProtoBufEvent_Click{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GogoProtoBufPurchase{}
+			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &GogoProtoBufEvent_Purchase{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GogoProtoBufSignup{}
+			if err := v.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &GogoProtoBufEvent_Signup{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructdefGogo(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("id")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GogoProtoBufClick) Unmarshal(data []byte) error {
+	var hasFields [1]uint64
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructdefGogo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GogoProtoBufClick: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GogoProtoBufClick: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Page = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field X", wireType)
+			}
+			m.X = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.X |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Y", wireType)
+			}
+			m.Y = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Y |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			hasFields[0] |= uint64(0x00000004)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructdefGogo(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("page")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("x")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("y")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GogoProtoBufPurchase) Unmarshal(data []byte) error {
+	var hasFields [1]uint64
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructdefGogo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GogoProtoBufPurchase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GogoProtoBufPurchase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Item = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Quantity |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(data[iNdEx-8])
+			v |= uint64(data[iNdEx-7]) << 8
+			v |= uint64(data[iNdEx-6]) << 16
+			v |= uint64(data[iNdEx-5]) << 24
+			v |= uint64(data[iNdEx-4]) << 32
+			v |= uint64(data[iNdEx-3]) << 40
+			v |= uint64(data[iNdEx-2]) << 48
+			v |= uint64(data[iNdEx-1]) << 56
+			m.Price = float64(math.Float64frombits(v))
+			hasFields[0] |= uint64(0x00000004)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructdefGogo(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("item")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("quantity")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("price")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GogoProtoBufSignup) Unmarshal(data []byte) error {
+	var hasFields [1]uint64
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructdefGogo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GogoProtoBufSignup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GogoProtoBufSignup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Email = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructdefGogo(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("email")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("referrer")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GogoProtoBufNode) Unmarshal(data []byte) error {
+	var hasFields [1]uint64
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructdefGogo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GogoProtoBufNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GogoProtoBufNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Value |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Children = append(m.Children, GogoProtoBufNode{})
+			if err := m.Children[len(m.Children)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructdefGogo(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("value")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GogoProtoBufSeriesUnpacked) Unmarshal(data []byte) error {
+	var hasFields [1]uint64
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructdefGogo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GogoProtoBufSeriesUnpacked: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GogoProtoBufSeriesUnpacked: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructdefGogo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStructdefGogo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStructdefGogo
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStructdefGogo
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Timestamps = append(m.Timestamps, v)
+				}
+			} else if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStructdefGogo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Timestamps = append(m.Timestamps, v)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamps", wireType)
+			}
+		case 3:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStructdefGogo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStructdefGogo
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += 8
+					v = uint64(data[iNdEx-8])
+					v |= uint64(data[iNdEx-7]) << 8
+					v |= uint64(data[iNdEx-6]) << 16
+					v |= uint64(data[iNdEx-5]) << 24
+					v |= uint64(data[iNdEx-4]) << 32
+					v |= uint64(data[iNdEx-3]) << 40
+					v |= uint64(data[iNdEx-2]) << 48
+					v |= uint64(data[iNdEx-1]) << 56
+					v2 := float64(math.Float64frombits(v))
+					m.Values = append(m.Values, v2)
+				}
+			} else if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx += 8
+				v = uint64(data[iNdEx-8])
+				v |= uint64(data[iNdEx-7]) << 8
+				v |= uint64(data[iNdEx-6]) << 16
+				v |= uint64(data[iNdEx-5]) << 24
+				v |= uint64(data[iNdEx-4]) << 32
+				v |= uint64(data[iNdEx-3]) << 40
+				v |= uint64(data[iNdEx-2]) << 48
+				v |= uint64(data[iNdEx-1]) << 56
+				v2 := float64(math.Float64frombits(v))
+				m.Values = append(m.Values, v2)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructdefGogo(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	}
-	data[offset] = uint8(v)
-	return offset + 1
-}
-func (m *GogoProtoBufA) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovStructdefGogo(uint64(l))
-	n += 1 + sovStructdefGogo(uint64(m.BirthDay))
-	l = len(m.Phone)
-	n += 1 + l + sovStructdefGogo(uint64(l))
-	n += 1 + sovStructdefGogo(uint64(m.Siblings))
-	n += 2
-	n += 9
-	return n
-}
 
-func sovStructdefGogo(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozStructdefGogo(x uint64) (n int) {
-	return sovStructdefGogo(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GogoProtoBufA) Unmarshal(data []byte) error {
+func (m *GogoProtoBufSeriesFixed) Unmarshal(data []byte) error {
 	var hasFields [1]uint64
 	l := len(data)
 	iNdEx := 0
@@ -210,10 +4441,10 @@ func (m *GogoProtoBufA) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GogoProtoBufA: wiretype end group for non-group")
+			return fmt.Errorf("proto: GogoProtoBufSeriesFixed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GogoProtoBufA: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GogoProtoBufSeriesFixed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -247,28 +4478,180 @@ func (m *GogoProtoBufA) Unmarshal(data []byte) error {
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BirthDay", wireType)
-			}
-			m.BirthDay = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStructdefGogo
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStructdefGogo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				if packedLen < 0 {
+					return ErrInvalidLengthStructdefGogo
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := data[iNdEx]
-				iNdEx++
-				m.BirthDay |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v int64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += 8
+					v = int64(data[iNdEx-8])
+					v |= int64(data[iNdEx-7]) << 8
+					v |= int64(data[iNdEx-6]) << 16
+					v |= int64(data[iNdEx-5]) << 24
+					v |= int64(data[iNdEx-4]) << 32
+					v |= int64(data[iNdEx-3]) << 40
+					v |= int64(data[iNdEx-2]) << 48
+					v |= int64(data[iNdEx-1]) << 56
+					m.Timestamps = append(m.Timestamps, v)
 				}
+			} else if wireType == 1 {
+				var v int64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx += 8
+				v = int64(data[iNdEx-8])
+				v |= int64(data[iNdEx-7]) << 8
+				v |= int64(data[iNdEx-6]) << 16
+				v |= int64(data[iNdEx-5]) << 24
+				v |= int64(data[iNdEx-4]) << 32
+				v |= int64(data[iNdEx-3]) << 40
+				v |= int64(data[iNdEx-2]) << 48
+				v |= int64(data[iNdEx-1]) << 56
+				m.Timestamps = append(m.Timestamps, v)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamps", wireType)
 			}
-			hasFields[0] |= uint64(0x00000002)
 		case 3:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStructdefGogo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStructdefGogo
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += 8
+					v = uint64(data[iNdEx-8])
+					v |= uint64(data[iNdEx-7]) << 8
+					v |= uint64(data[iNdEx-6]) << 16
+					v |= uint64(data[iNdEx-5]) << 24
+					v |= uint64(data[iNdEx-4]) << 32
+					v |= uint64(data[iNdEx-3]) << 40
+					v |= uint64(data[iNdEx-2]) << 48
+					v |= uint64(data[iNdEx-1]) << 56
+					v2 := float64(math.Float64frombits(v))
+					m.Values = append(m.Values, v2)
+				}
+			} else if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx += 8
+				v = uint64(data[iNdEx-8])
+				v |= uint64(data[iNdEx-7]) << 8
+				v |= uint64(data[iNdEx-6]) << 16
+				v |= uint64(data[iNdEx-5]) << 24
+				v |= uint64(data[iNdEx-4]) << 32
+				v |= uint64(data[iNdEx-3]) << 40
+				v |= uint64(data[iNdEx-2]) << 48
+				v |= uint64(data[iNdEx-1]) << 56
+				v2 := float64(math.Float64frombits(v))
+				m.Values = append(m.Values, v2)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructdefGogo(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructdefGogo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GogoProtoBufSeriesZigzag) Unmarshal(data []byte) error {
+	var hasFields [1]uint64
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructdefGogo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GogoProtoBufSeriesZigzag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GogoProtoBufSeriesZigzag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -293,69 +4676,133 @@ func (m *GogoProtoBufA) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phone = string(data[iNdEx:postIndex])
+			m.Name = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000004)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Siblings", wireType)
-			}
-			m.Siblings = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStructdefGogo
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStructdefGogo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				if packedLen < 0 {
+					return ErrInvalidLengthStructdefGogo
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := data[iNdEx]
-				iNdEx++
-				m.Siblings |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStructdefGogo
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := data[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+					m.Timestamps = append(m.Timestamps, int64(v))
 				}
+			} else if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStructdefGogo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+				m.Timestamps = append(m.Timestamps, int64(v))
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamps", wireType)
 			}
-			hasFields[0] |= uint64(0x00000008)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spouse", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStructdefGogo
+		case 3:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStructdefGogo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				if packedLen < 0 {
+					return ErrInvalidLengthStructdefGogo
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := data[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += 8
+					v = uint64(data[iNdEx-8])
+					v |= uint64(data[iNdEx-7]) << 8
+					v |= uint64(data[iNdEx-6]) << 16
+					v |= uint64(data[iNdEx-5]) << 24
+					v |= uint64(data[iNdEx-4]) << 32
+					v |= uint64(data[iNdEx-3]) << 40
+					v |= uint64(data[iNdEx-2]) << 48
+					v |= uint64(data[iNdEx-1]) << 56
+					v2 := float64(math.Float64frombits(v))
+					m.Values = append(m.Values, v2)
 				}
+			} else if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx += 8
+				v = uint64(data[iNdEx-8])
+				v |= uint64(data[iNdEx-7]) << 8
+				v |= uint64(data[iNdEx-6]) << 16
+				v |= uint64(data[iNdEx-5]) << 24
+				v |= uint64(data[iNdEx-4]) << 32
+				v |= uint64(data[iNdEx-3]) << 40
+				v |= uint64(data[iNdEx-2]) << 48
+				v |= uint64(data[iNdEx-1]) << 56
+				v2 := float64(math.Float64frombits(v))
+				m.Values = append(m.Values, v2)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
-			m.Spouse = bool(v != 0)
-			hasFields[0] |= uint64(0x00000010)
-		case 6:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Money", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 8
-			v = uint64(data[iNdEx-8])
-			v |= uint64(data[iNdEx-7]) << 8
-			v |= uint64(data[iNdEx-6]) << 16
-			v |= uint64(data[iNdEx-5]) << 24
-			v |= uint64(data[iNdEx-4]) << 32
-			v |= uint64(data[iNdEx-3]) << 40
-			v |= uint64(data[iNdEx-2]) << 48
-			v |= uint64(data[iNdEx-1]) << 56
-			m.Money = float64(math.Float64frombits(v))
-			hasFields[0] |= uint64(0x00000020)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStructdefGogo(data[iNdEx:])
@@ -374,21 +4821,6 @@ func (m *GogoProtoBufA) Unmarshal(data []byte) error {
 	if hasFields[0]&uint64(0x00000001) == 0 {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("birthDay")
-	}
-	if hasFields[0]&uint64(0x00000004) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("phone")
-	}
-	if hasFields[0]&uint64(0x00000008) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("siblings")
-	}
-	if hasFields[0]&uint64(0x00000010) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("spouse")
-	}
-	if hasFields[0]&uint64(0x00000020) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("money")
-	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -501,19 +4933,55 @@ var (
 )
 
 var fileDescriptorStructdefGogo = []byte{
-	// 209 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe2, 0x12, 0x29, 0x2e, 0x29, 0x2a,
-	0x4d, 0x2e, 0x49, 0x49, 0x4d, 0xd3, 0x4d, 0xcf, 0x4f, 0xcf, 0xd7, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0xe2, 0x4a, 0xcf, 0x2f, 0x4e, 0x2d, 0x4a, 0x4a, 0xcd, 0x4b, 0xce, 0x90, 0xd2, 0x4d, 0xcf,
-	0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x07, 0x29, 0xd1, 0x07, 0x2b, 0x49, 0x2a,
-	0x4d, 0x03, 0xf3, 0xc0, 0x1c, 0x7d, 0x84, 0x56, 0xa5, 0xc9, 0x8c, 0x5c, 0xbc, 0xee, 0x40, 0x6e,
-	0x00, 0x88, 0xe7, 0x54, 0x9a, 0xe6, 0x28, 0x24, 0xc4, 0xc5, 0x92, 0x97, 0x98, 0x9b, 0x2a, 0xc1,
-	0xa8, 0xc0, 0xa4, 0xc1, 0xe9, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x83, 0x90, 0x18, 0x17, 0x47, 0x52,
-	0x66, 0x51, 0x49, 0x86, 0x4b, 0x62, 0xa5, 0x04, 0x13, 0x50, 0x9c, 0x19, 0x2a, 0x2e, 0xcc, 0xc5,
-	0x5a, 0x90, 0x91, 0x9f, 0x97, 0x2a, 0xc1, 0x8c, 0xaa, 0xb8, 0x38, 0x33, 0x29, 0x27, 0x33, 0x2f,
-	0xbd, 0x58, 0x82, 0x05, 0x28, 0xce, 0x0a, 0x15, 0x17, 0xe1, 0x62, 0x2b, 0x2e, 0xc8, 0x2f, 0x2d,
-	0x4e, 0x95, 0x60, 0x05, 0x8a, 0x72, 0x20, 0x8c, 0xc8, 0x05, 0x9a, 0x50, 0x29, 0xc1, 0x06, 0x14,
-	0x64, 0x84, 0x08, 0x3a, 0x09, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0x01, 0x88, 0x1f, 0x00, 0xf1, 0x84,
-	0xc7, 0x72, 0x0c, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdf, 0xf7, 0xd6, 0xfc, 0xf9, 0x00, 0x00,
-	0x00,
+	// 796 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xcc, 0x54, 0x5d, 0x6f, 0xe3, 0x44,
+	0x14, 0xcd, 0xf8, 0x23, 0x1f, 0x37, 0x1b, 0x9a, 0x4e, 0xbb, 0xcb, 0x50, 0x41, 0xd6, 0xf2, 0x93,
+	0x11, 0xda, 0x74, 0x15, 0x2d, 0x1f, 0xda, 0xb7, 0x0d, 0x14, 0xca, 0x0b, 0x54, 0xda, 0xae, 0x10,
+	0xfb, 0x36, 0xb1, 0x27, 0xce, 0xa8, 0x8e, 0xc7, 0x3b, 0x33, 0x5e, 0x36, 0xfd, 0x15, 0x95, 0xf8,
+	0x4d, 0x48, 0x7d, 0xe4, 0x95, 0x17, 0x84, 0xca, 0x1f, 0x41, 0x76, 0x9c, 0xd8, 0x26, 0x31, 0x2a,
+	0xe2, 0x85, 0xc7, 0xb9, 0xbe, 0x73, 0x7c, 0xcf, 0x39, 0xf7, 0x0c, 0x1c, 0x2b, 0x2d, 0x53, 0x5f,
+	0x07, 0x6c, 0xfe, 0x24, 0x14, 0xa1, 0x18, 0x27, 0x52, 0x68, 0x81, 0x21, 0x14, 0x8a, 0xc9, 0x19,
+	0x8b, 0xfd, 0xc5, 0xc9, 0x93, 0x90, 0xeb, 0x45, 0x3a, 0x1b, 0xfb, 0x62, 0x79, 0x9a, 0xb5, 0x9c,
+	0xe6, 0x2d, 0xb3, 0x74, 0x9e, 0x9f, 0xf2, 0xc3, 0x69, 0x79, 0xd5, 0xfd, 0x19, 0xc1, 0xe0, 0x1b,
+	0x11, 0x8a, 0x8b, 0xec, 0x34, 0x4d, 0xe7, 0x2f, 0x30, 0x06, 0x2b, 0xa6, 0x4b, 0x46, 0x90, 0x63,
+	0x78, 0xbd, 0xa9, 0x75, 0xfb, 0xfb, 0xe3, 0x16, 0x7e, 0x04, 0xdd, 0x19, 0x97, 0x7a, 0xf1, 0x15,
+	0x5d, 0x11, 0xc3, 0x31, 0x3c, 0xb3, 0xa8, 0x1f, 0x81, 0x9d, 0x2c, 0x44, 0xcc, 0x88, 0x59, 0x6f,
+	0x56, 0x7c, 0x16, 0xf1, 0x38, 0x54, 0xc4, 0x72, 0x0c, 0xcf, 0x2e, 0xea, 0xc7, 0xd0, 0x56, 0x89,
+	0x48, 0x15, 0x23, 0xb6, 0x63, 0x78, 0xdd, 0x12, 0x62, 0x29, 0x62, 0xb6, 0x22, 0x6d, 0xc7, 0xf0,
+	0xd0, 0xba, 0xe8, 0xfe, 0x86, 0x00, 0x57, 0xa7, 0xba, 0x60, 0x52, 0x89, 0xf8, 0xbf, 0x8f, 0xb6,
+	0xfd, 0x99, 0x55, 0xfe, 0x0c, 0x7f, 0x06, 0x1d, 0x1a, 0x04, 0x92, 0x29, 0x95, 0x0f, 0xd6, 0x9f,
+	0x3c, 0x1e, 0x97, 0x7a, 0x8e, 0x6b, 0xe2, 0xac, 0xdb, 0x8a, 0x7b, 0x9f, 0x42, 0xd7, 0x5f, 0xf0,
+	0x28, 0x90, 0x2c, 0x26, 0x6d, 0xc7, 0xf4, 0xfa, 0x93, 0x8f, 0x9a, 0x2e, 0x7e, 0x99, 0xf5, 0x15,
+	0xdc, 0x38, 0x1c, 0xed, 0xc1, 0xcc, 0xd5, 0xd1, 0x92, 0x31, 0x5d, 0x63, 0x87, 0xc1, 0xf2, 0xb9,
+	0x5e, 0x33, 0xdb, 0xd4, 0x0e, 0xc1, 0xbc, 0xe6, 0x49, 0x8d, 0xd7, 0x43, 0xe8, 0xf8, 0x22, 0x8d,
+	0xb5, 0x5c, 0x33, 0x2b, 0xca, 0xee, 0x25, 0x1c, 0xee, 0x4c, 0xf1, 0x6f, 0x45, 0x0c, 0x25, 0x0d,
+	0xd6, 0x22, 0x16, 0x3e, 0xba, 0x37, 0x46, 0x9d, 0xc1, 0x25, 0x0d, 0x43, 0x16, 0xfc, 0x2f, 0x16,
+	0x07, 0x7f, 0x0e, 0x96, 0xa6, 0xa1, 0x22, 0x9d, 0xdc, 0x8f, 0x8f, 0x9b, 0xfc, 0x28, 0x46, 0x1e,
+	0x5f, 0xd2, 0x50, 0x9d, 0x65, 0xb2, 0xe1, 0x03, 0xe8, 0xd0, 0x88, 0x53, 0xc5, 0x14, 0xe9, 0x3a,
+	0xa6, 0xd7, 0x3b, 0xf9, 0x04, 0x7a, 0xe5, 0xd7, 0x3e, 0x98, 0x57, 0x6c, 0x45, 0x90, 0x83, 0xbc,
+	0x1e, 0x1e, 0x80, 0xfd, 0x96, 0x46, 0x29, 0x23, 0x46, 0x76, 0x7c, 0x6e, 0x7c, 0x81, 0xdc, 0xe7,
+	0x30, 0xac, 0xc2, 0x4f, 0x23, 0x31, 0xdb, 0x2b, 0x07, 0x06, 0x2b, 0xa0, 0x9a, 0xe6, 0x52, 0x3c,
+	0x28, 0xe4, 0x3c, 0xab, 0x9b, 0x34, 0xa5, 0xda, 0x5f, 0xe0, 0xa7, 0xd0, 0x91, 0xcc, 0x17, 0x32,
+	0x50, 0x04, 0xe5, 0x54, 0x3e, 0x68, 0xdc, 0xc9, 0xad, 0xd7, 0xb5, 0xc4, 0xbc, 0x64, 0x92, 0x33,
+	0xd5, 0xe0, 0x09, 0x68, 0xbe, 0x64, 0x4a, 0xd3, 0x65, 0xa2, 0x88, 0xe1, 0x98, 0x9e, 0x39, 0x35,
+	0x86, 0x08, 0x63, 0x68, 0xe7, 0xbc, 0x14, 0x31, 0x1d, 0xd3, 0x43, 0x59, 0xcd, 0xfd, 0x09, 0x1e,
+	0x56, 0x51, 0xbf, 0x4f, 0x34, 0x17, 0x31, 0x8d, 0x5e, 0xe0, 0x07, 0x5b, 0xe0, 0x4c, 0x92, 0x61,
+	0xcd, 0x66, 0xe4, 0x99, 0x78, 0x50, 0x1a, 0x5c, 0x34, 0x54, 0xac, 0x45, 0x9e, 0x8d, 0xdf, 0xab,
+	0x98, 0x8a, 0xbc, 0x2e, 0x1e, 0x94, 0x76, 0x22, 0x0f, 0xb9, 0xbf, 0xa0, 0xba, 0x2c, 0x67, 0x6f,
+	0x59, 0xac, 0xf1, 0x10, 0x0c, 0x1e, 0x10, 0x54, 0x59, 0xa4, 0x31, 0xd8, 0x7e, 0xc4, 0xfd, 0xab,
+	0xfc, 0xb7, 0xff, 0x94, 0xc0, 0xac, 0xe9, 0xbc, 0x85, 0x9f, 0x41, 0x37, 0x49, 0xa5, 0xbf, 0xa0,
+	0x6a, 0x3d, 0x5a, 0x7f, 0xe2, 0x34, 0x5d, 0xb9, 0x28, 0xfa, 0xce, 0x5b, 0xf8, 0x29, 0xb4, 0x15,
+	0x0f, 0xe3, 0x34, 0xc9, 0x87, 0xef, 0x4f, 0x46, 0x4d, 0x77, 0x5e, 0xe6, 0x5d, 0xe7, 0xad, 0x69,
+	0x1b, 0xac, 0x99, 0x08, 0x56, 0xee, 0xb7, 0x70, 0xb8, 0x33, 0x46, 0xe6, 0x4a, 0x42, 0xc3, 0xba,
+	0x2b, 0x07, 0x80, 0xde, 0x11, 0xa3, 0xb2, 0xf5, 0x07, 0x80, 0x56, 0xb5, 0xdc, 0xfd, 0x00, 0xc7,
+	0xfb, 0xc6, 0xcb, 0xd0, 0xb8, 0x66, 0xcb, 0xbf, 0xe7, 0xee, 0x4d, 0x4a, 0x63, 0xbd, 0x79, 0x3b,
+	0xec, 0x4a, 0xee, 0x24, 0xf7, 0xd7, 0xb9, 0xdb, 0xbc, 0xb6, 0xaf, 0x00, 0xef, 0x72, 0xd8, 0xbb,
+	0x3a, 0x47, 0x60, 0xb3, 0x25, 0xe5, 0x51, 0xed, 0x3d, 0x7a, 0x04, 0x5d, 0xc9, 0xe6, 0x4c, 0x4a,
+	0x26, 0xab, 0x71, 0x76, 0xdf, 0xd4, 0x43, 0xf1, 0x9d, 0x08, 0x58, 0x13, 0xe8, 0x26, 0x4f, 0xa5,
+	0xaf, 0xcf, 0x2a, 0x8f, 0xab, 0x99, 0x27, 0xe0, 0xc3, 0x26, 0xcd, 0x33, 0xe0, 0x6d, 0x08, 0x4e,
+	0x76, 0x43, 0xf0, 0x2a, 0x4e, 0xa8, 0x7f, 0xc5, 0x82, 0x86, 0x44, 0xee, 0x84, 0x21, 0x5b, 0xcd,
+	0x6a, 0x10, 0xdc, 0x1f, 0xe1, 0xfd, 0x5d, 0xd4, 0xaf, 0xf9, 0x3b, 0x16, 0xdc, 0x33, 0x5f, 0xc3,
+	0xc6, 0x7c, 0xbd, 0x06, 0xb2, 0x0b, 0xfd, 0x9a, 0x87, 0xd7, 0x34, 0xbc, 0x27, 0x36, 0x6e, 0xc2,
+	0x9e, 0x0e, 0x6f, 0xef, 0x46, 0xe8, 0xd7, 0xbb, 0x11, 0xfa, 0xe3, 0x6e, 0x84, 0x6e, 0xfe, 0x1c,
+	0xb5, 0xfe, 0x1a, 0x00, 0xa1, 0x20, 0x45, 0xe5, 0x3e, 0x08, 0x00, 0x00,
 }
@@ -23,3 +23,127 @@ type NoTimeA struct {
 	Spouse   bool
 	Money    float64
 }
+
+//easyjson:json
+type Person struct {
+	Name     string
+	BirthDay time.Time
+	Phone    string
+	Money    float64
+	Address  Address
+	Children []Child
+}
+
+type Address struct {
+	Street  string
+	City    string
+	Zip     string
+	Country string
+}
+
+type Child struct {
+	Name     string
+	BirthDay time.Time
+	Grade    int
+}
+
+//easyjson:json
+type TaggedA struct {
+	Name     string
+	BirthDay time.Time
+	Phone    string
+	Siblings int
+	Spouse   bool
+	Money    float64
+	Tags     map[string]string
+	Aliases  []string
+}
+
+//easyjson:json
+type Blob struct {
+	Name string
+	Data []byte
+}
+
+//easyjson:json
+type Batch struct {
+	Records []A
+}
+
+//easyjson:json
+type Series struct {
+	Name       string
+	Timestamps []int64
+	Values     []float64
+}
+
+// OmitEmptyA is A tagged for the codecs that leave out zero fields on
+// request. BirthDay is a struct, which encoding/json never leaves out.
+//
+//easyjson:json
+type OmitEmptyA struct {
+	Name     string `json:",omitempty" bson:",omitempty"`
+	BirthDay time.Time
+	Phone    string  `json:",omitempty" bson:",omitempty"`
+	Siblings int     `json:",omitempty" bson:",omitempty"`
+	Spouse   bool    `json:",omitempty" bson:",omitempty"`
+	Money    float64 `json:",omitempty" bson:",omitempty"`
+}
+
+// Event carries one of several event types, behind an interface.
+//
+//msgp:ignore Event
+type Event struct {
+	ID   int64
+	Body EventBody
+}
+
+// EventBody is implemented by the event types an Event may carry.
+type EventBody interface {
+	eventBody()
+}
+
+//easyjson:json
+type Click struct {
+	Page string
+	X    int
+	Y    int
+}
+
+//easyjson:json
+type Purchase struct {
+	Item     string
+	Quantity int
+	Price    float64
+}
+
+//easyjson:json
+type Signup struct {
+	Name     string
+	Email    string
+	Referrer string
+}
+
+func (*Click) eventBody()    {}
+func (*Purchase) eventBody() {}
+func (*Signup) eventBody()   {}
+
+// EventEnvelope is Event for the codecs without unions or interface types.
+// The body is in the field of its type, all others are nil.
+//
+//easyjson:json
+type EventEnvelope struct {
+	ID       int64
+	Click    *Click    `json:",omitempty" bson:",omitempty"`
+	Purchase *Purchase `json:",omitempty" bson:",omitempty"`
+	Signup   *Signup   `json:",omitempty" bson:",omitempty"`
+}
+
+// Node is a node of a tree, whose children nest to any depth.
+//
+//easyjson:json
+type Node struct {
+	Name     string
+	Value    int64
+	Children []Node
+}
@@ -10,6 +10,22 @@ It is generated from these files:
 
 It has these top-level messages:
 	ProtoBufA
+	ProtoBufPerson
+	ProtoBufAddress
+	ProtoBufChild
+	ProtoBufTaggedA
+	ProtoBufBlob
+	ProtoBufBatch
+	ProtoBufSeries
+	ProtoBufOptionalA
+	ProtoBufEvent
+	ProtoBufClick
+	ProtoBufPurchase
+	ProtoBufSignup
+	ProtoBufNode
+	ProtoBufSeriesUnpacked
+	ProtoBufSeriesFixed
+	ProtoBufSeriesZigzag
 */
 package goserbench
 
@@ -83,20 +99,714 @@ func (m *ProtoBufA) GetMoney() float64 {
 	return 0
 }
 
+type ProtoBufPerson struct {
+	Name             *string          `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	BirthDay         *int64           `protobuf:"varint,2,req,name=birthDay" json:"birthDay,omitempty"`
+	Phone            *string          `protobuf:"bytes,3,req,name=phone" json:"phone,omitempty"`
+	Money            *float64         `protobuf:"fixed64,4,req,name=money" json:"money,omitempty"`
+	Address          *ProtoBufAddress `protobuf:"bytes,5,req,name=address" json:"address,omitempty"`
+	Children         []*ProtoBufChild `protobuf:"bytes,6,rep,name=children" json:"children,omitempty"`
+	XXX_unrecognized []byte           `json:"-"`
+}
+
+func (m *ProtoBufPerson) Reset()                    { *m = ProtoBufPerson{} }
+func (m *ProtoBufPerson) String() string            { return proto.CompactTextString(m) }
+func (*ProtoBufPerson) ProtoMessage()               {}
+func (*ProtoBufPerson) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *ProtoBufPerson) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ProtoBufPerson) GetBirthDay() int64 {
+	if m != nil && m.BirthDay != nil {
+		return *m.BirthDay
+	}
+	return 0
+}
+
+func (m *ProtoBufPerson) GetPhone() string {
+	if m != nil && m.Phone != nil {
+		return *m.Phone
+	}
+	return ""
+}
+
+func (m *ProtoBufPerson) GetMoney() float64 {
+	if m != nil && m.Money != nil {
+		return *m.Money
+	}
+	return 0
+}
+
+func (m *ProtoBufPerson) GetAddress() *ProtoBufAddress {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *ProtoBufPerson) GetChildren() []*ProtoBufChild {
+	if m != nil {
+		return m.Children
+	}
+	return nil
+}
+
+type ProtoBufAddress struct {
+	Street           *string `protobuf:"bytes,1,req,name=street" json:"street,omitempty"`
+	City             *string `protobuf:"bytes,2,req,name=city" json:"city,omitempty"`
+	Zip              *string `protobuf:"bytes,3,req,name=zip" json:"zip,omitempty"`
+	Country          *string `protobuf:"bytes,4,req,name=country" json:"country,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *ProtoBufAddress) Reset()                    { *m = ProtoBufAddress{} }
+func (m *ProtoBufAddress) String() string            { return proto.CompactTextString(m) }
+func (*ProtoBufAddress) ProtoMessage()               {}
+func (*ProtoBufAddress) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *ProtoBufAddress) GetStreet() string {
+	if m != nil && m.Street != nil {
+		return *m.Street
+	}
+	return ""
+}
+
+func (m *ProtoBufAddress) GetCity() string {
+	if m != nil && m.City != nil {
+		return *m.City
+	}
+	return ""
+}
+
+func (m *ProtoBufAddress) GetZip() string {
+	if m != nil && m.Zip != nil {
+		return *m.Zip
+	}
+	return ""
+}
+
+func (m *ProtoBufAddress) GetCountry() string {
+	if m != nil && m.Country != nil {
+		return *m.Country
+	}
+	return ""
+}
+
+type ProtoBufChild struct {
+	Name             *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	BirthDay         *int64  `protobuf:"varint,2,req,name=birthDay" json:"birthDay,omitempty"`
+	Grade            *int32  `protobuf:"varint,3,req,name=grade" json:"grade,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *ProtoBufChild) Reset()                    { *m = ProtoBufChild{} }
+func (m *ProtoBufChild) String() string            { return proto.CompactTextString(m) }
+func (*ProtoBufChild) ProtoMessage()               {}
+func (*ProtoBufChild) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *ProtoBufChild) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ProtoBufChild) GetBirthDay() int64 {
+	if m != nil && m.BirthDay != nil {
+		return *m.BirthDay
+	}
+	return 0
+}
+
+func (m *ProtoBufChild) GetGrade() int32 {
+	if m != nil && m.Grade != nil {
+		return *m.Grade
+	}
+	return 0
+}
+
+type ProtoBufTaggedA struct {
+	Name             *string           `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	BirthDay         *int64            `protobuf:"varint,2,req,name=birthDay" json:"birthDay,omitempty"`
+	Phone            *string           `protobuf:"bytes,3,req,name=phone" json:"phone,omitempty"`
+	Siblings         *int32            `protobuf:"varint,4,req,name=siblings" json:"siblings,omitempty"`
+	Spouse           *bool             `protobuf:"varint,5,req,name=spouse" json:"spouse,omitempty"`
+	Money            *float64          `protobuf:"fixed64,6,req,name=money" json:"money,omitempty"`
+	Tags             map[string]string `protobuf:"bytes,7,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Aliases          []string          `protobuf:"bytes,8,rep,name=aliases" json:"aliases,omitempty"`
+	XXX_unrecognized []byte            `json:"-"`
+}
+
+func (m *ProtoBufTaggedA) Reset()                    { *m = ProtoBufTaggedA{} }
+func (m *ProtoBufTaggedA) String() string            { return proto.CompactTextString(m) }
+func (*ProtoBufTaggedA) ProtoMessage()               {}
+func (*ProtoBufTaggedA) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *ProtoBufTaggedA) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ProtoBufTaggedA) GetBirthDay() int64 {
+	if m != nil && m.BirthDay != nil {
+		return *m.BirthDay
+	}
+	return 0
+}
+
+func (m *ProtoBufTaggedA) GetPhone() string {
+	if m != nil && m.Phone != nil {
+		return *m.Phone
+	}
+	return ""
+}
+
+func (m *ProtoBufTaggedA) GetSiblings() int32 {
+	if m != nil && m.Siblings != nil {
+		return *m.Siblings
+	}
+	return 0
+}
+
+func (m *ProtoBufTaggedA) GetSpouse() bool {
+	if m != nil && m.Spouse != nil {
+		return *m.Spouse
+	}
+	return false
+}
+
+func (m *ProtoBufTaggedA) GetMoney() float64 {
+	if m != nil && m.Money != nil {
+		return *m.Money
+	}
+	return 0
+}
+
+func (m *ProtoBufTaggedA) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *ProtoBufTaggedA) GetAliases() []string {
+	if m != nil {
+		return m.Aliases
+	}
+	return nil
+}
+
+type ProtoBufBlob struct {
+	Name             *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Data             []byte  `protobuf:"bytes,2,req,name=data" json:"data,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *ProtoBufBlob) Reset()                    { *m = ProtoBufBlob{} }
+func (m *ProtoBufBlob) String() string            { return proto.CompactTextString(m) }
+func (*ProtoBufBlob) ProtoMessage()               {}
+func (*ProtoBufBlob) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *ProtoBufBlob) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ProtoBufBlob) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ProtoBufBatch struct {
+	Records          []*ProtoBufA `protobuf:"bytes,1,rep,name=records" json:"records,omitempty"`
+	XXX_unrecognized []byte       `json:"-"`
+}
+
+func (m *ProtoBufBatch) Reset()                    { *m = ProtoBufBatch{} }
+func (m *ProtoBufBatch) String() string            { return proto.CompactTextString(m) }
+func (*ProtoBufBatch) ProtoMessage()               {}
+func (*ProtoBufBatch) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *ProtoBufBatch) GetRecords() []*ProtoBufA {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+type ProtoBufSeries struct {
+	Name             *string   `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Timestamps       []int64   `protobuf:"varint,2,rep,name=timestamps,packed" json:"timestamps,omitempty"`
+	Values           []float64 `protobuf:"fixed64,3,rep,name=values,packed" json:"values,omitempty"`
+	XXX_unrecognized []byte    `json:"-"`
+}
+
+func (m *ProtoBufSeries) Reset()                    { *m = ProtoBufSeries{} }
+func (m *ProtoBufSeries) String() string            { return proto.CompactTextString(m) }
+func (*ProtoBufSeries) ProtoMessage()               {}
+func (*ProtoBufSeries) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *ProtoBufSeries) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ProtoBufSeries) GetTimestamps() []int64 {
+	if m != nil {
+		return m.Timestamps
+	}
+	return nil
+}
+
+func (m *ProtoBufSeries) GetValues() []float64 {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+type ProtoBufOptionalA struct {
+	Name             *string  `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	BirthDay         *int64   `protobuf:"varint,2,opt,name=birthDay" json:"birthDay,omitempty"`
+	Phone            *string  `protobuf:"bytes,3,opt,name=phone" json:"phone,omitempty"`
+	Siblings         *int32   `protobuf:"varint,4,opt,name=siblings" json:"siblings,omitempty"`
+	Spouse           *bool    `protobuf:"varint,5,opt,name=spouse" json:"spouse,omitempty"`
+	Money            *float64 `protobuf:"fixed64,6,opt,name=money" json:"money,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *ProtoBufOptionalA) Reset()                    { *m = ProtoBufOptionalA{} }
+func (m *ProtoBufOptionalA) String() string            { return proto.CompactTextString(m) }
+func (*ProtoBufOptionalA) ProtoMessage()               {}
+func (*ProtoBufOptionalA) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *ProtoBufOptionalA) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ProtoBufOptionalA) GetBirthDay() int64 {
+	if m != nil && m.BirthDay != nil {
+		return *m.BirthDay
+	}
+	return 0
+}
+
+func (m *ProtoBufOptionalA) GetPhone() string {
+	if m != nil && m.Phone != nil {
+		return *m.Phone
+	}
+	return ""
+}
+
+func (m *ProtoBufOptionalA) GetSiblings() int32 {
+	if m != nil && m.Siblings != nil {
+		return *m.Siblings
+	}
+	return 0
+}
+
+func (m *ProtoBufOptionalA) GetSpouse() bool {
+	if m != nil && m.Spouse != nil {
+		return *m.Spouse
+	}
+	return false
+}
+
+func (m *ProtoBufOptionalA) GetMoney() float64 {
+	if m != nil && m.Money != nil {
+		return *m.Money
+	}
+	return 0
+}
+
+type ProtoBufEvent struct {
+	Id *int64 `protobuf:"varint,1,req,name=id" json:"id,omitempty"`
+	// Types that are valid to be assigned to Body:
+	//	*ProtoBufEvent_Click
+	//	*ProtoBufEvent_Purchase
+	//	*ProtoBufEvent_Signup
+	Body             isProtoBufEvent_Body `protobuf_oneof:"body"`
+	XXX_unrecognized []byte               `json:"-"`
+}
+
+func (m *ProtoBufEvent) Reset()                    { *m = ProtoBufEvent{} }
+func (m *ProtoBufEvent) String() string            { return proto.CompactTextString(m) }
+func (*ProtoBufEvent) ProtoMessage()               {}
+func (*ProtoBufEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+type isProtoBufEvent_Body interface {
+	isProtoBufEvent_Body()
+}
+
+type ProtoBufEvent_Click struct {
+	Click *ProtoBufClick `protobuf:"bytes,2,opt,name=click,oneof"`
+}
+type ProtoBufEvent_Purchase struct {
+	Purchase *ProtoBufPurchase `protobuf:"bytes,3,opt,name=purchase,oneof"`
+}
+type ProtoBufEvent_Signup struct {
+	Signup *ProtoBufSignup `protobuf:"bytes,4,opt,name=signup,oneof"`
+}
+
+func (*ProtoBufEvent_Click) isProtoBufEvent_Body()    {}
+func (*ProtoBufEvent_Purchase) isProtoBufEvent_Body() {}
+func (*ProtoBufEvent_Signup) isProtoBufEvent_Body()   {}
+
+func (m *ProtoBufEvent) GetBody() isProtoBufEvent_Body {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+func (m *ProtoBufEvent) GetId() int64 {
+	if m != nil && m.Id != nil {
+		return *m.Id
+	}
+	return 0
+}
+
+func (m *ProtoBufEvent) GetClick() *ProtoBufClick {
+	if x, ok := m.GetBody().(*ProtoBufEvent_Click); ok {
+		return x.Click
+	}
+	return nil
+}
+
+func (m *ProtoBufEvent) GetPurchase() *ProtoBufPurchase {
+	if x, ok := m.GetBody().(*ProtoBufEvent_Purchase); ok {
+		return x.Purchase
+	}
+	return nil
+}
+
+func (m *ProtoBufEvent) GetSignup() *ProtoBufSignup {
+	if x, ok := m.GetBody().(*ProtoBufEvent_Signup); ok {
+		return x.Signup
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ProtoBufEvent) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ProtoBufEvent_Click)(nil),
+		(*ProtoBufEvent_Purchase)(nil),
+		(*ProtoBufEvent_Signup)(nil),
+	}
+}
+
+type ProtoBufClick struct {
+	Page             *string `protobuf:"bytes,1,req,name=page" json:"page,omitempty"`
+	X                *int32  `protobuf:"varint,2,req,name=x" json:"x,omitempty"`
+	Y                *int32  `protobuf:"varint,3,req,name=y" json:"y,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *ProtoBufClick) Reset()                    { *m = ProtoBufClick{} }
+func (m *ProtoBufClick) String() string            { return proto.CompactTextString(m) }
+func (*ProtoBufClick) ProtoMessage()               {}
+func (*ProtoBufClick) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *ProtoBufClick) GetPage() string {
+	if m != nil && m.Page != nil {
+		return *m.Page
+	}
+	return ""
+}
+
+func (m *ProtoBufClick) GetX() int32 {
+	if m != nil && m.X != nil {
+		return *m.X
+	}
+	return 0
+}
+
+func (m *ProtoBufClick) GetY() int32 {
+	if m != nil && m.Y != nil {
+		return *m.Y
+	}
+	return 0
+}
+
+type ProtoBufPurchase struct {
+	Item             *string  `protobuf:"bytes,1,req,name=item" json:"item,omitempty"`
+	Quantity         *int32   `protobuf:"varint,2,req,name=quantity" json:"quantity,omitempty"`
+	Price            *float64 `protobuf:"fixed64,3,req,name=price" json:"price,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *ProtoBufPurchase) Reset()                    { *m = ProtoBufPurchase{} }
+func (m *ProtoBufPurchase) String() string            { return proto.CompactTextString(m) }
+func (*ProtoBufPurchase) ProtoMessage()               {}
+func (*ProtoBufPurchase) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *ProtoBufPurchase) GetItem() string {
+	if m != nil && m.Item != nil {
+		return *m.Item
+	}
+	return ""
+}
+
+func (m *ProtoBufPurchase) GetQuantity() int32 {
+	if m != nil && m.Quantity != nil {
+		return *m.Quantity
+	}
+	return 0
+}
+
+func (m *ProtoBufPurchase) GetPrice() float64 {
+	if m != nil && m.Price != nil {
+		return *m.Price
+	}
+	return 0
+}
+
+type ProtoBufSignup struct {
+	Name             *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Email            *string `protobuf:"bytes,2,req,name=email" json:"email,omitempty"`
+	Referrer         *string `protobuf:"bytes,3,req,name=referrer" json:"referrer,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *ProtoBufSignup) Reset()                    { *m = ProtoBufSignup{} }
+func (m *ProtoBufSignup) String() string            { return proto.CompactTextString(m) }
+func (*ProtoBufSignup) ProtoMessage()               {}
+func (*ProtoBufSignup) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *ProtoBufSignup) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ProtoBufSignup) GetEmail() string {
+	if m != nil && m.Email != nil {
+		return *m.Email
+	}
+	return ""
+}
+
+func (m *ProtoBufSignup) GetReferrer() string {
+	if m != nil && m.Referrer != nil {
+		return *m.Referrer
+	}
+	return ""
+}
+
+type ProtoBufNode struct {
+	Name             *string         `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Value            *int64          `protobuf:"varint,2,req,name=value" json:"value,omitempty"`
+	Children         []*ProtoBufNode `protobuf:"bytes,3,rep,name=children" json:"children,omitempty"`
+	XXX_unrecognized []byte          `json:"-"`
+}
+
+func (m *ProtoBufNode) Reset()                    { *m = ProtoBufNode{} }
+func (m *ProtoBufNode) String() string            { return proto.CompactTextString(m) }
+func (*ProtoBufNode) ProtoMessage()               {}
+func (*ProtoBufNode) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *ProtoBufNode) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ProtoBufNode) GetValue() int64 {
+	if m != nil && m.Value != nil {
+		return *m.Value
+	}
+	return 0
+}
+
+func (m *ProtoBufNode) GetChildren() []*ProtoBufNode {
+	if m != nil {
+		return m.Children
+	}
+	return nil
+}
+
+type ProtoBufSeriesUnpacked struct {
+	Name             *string   `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Timestamps       []int64   `protobuf:"varint,2,rep,name=timestamps" json:"timestamps,omitempty"`
+	Values           []float64 `protobuf:"fixed64,3,rep,name=values" json:"values,omitempty"`
+	XXX_unrecognized []byte    `json:"-"`
+}
+
+func (m *ProtoBufSeriesUnpacked) Reset()                    { *m = ProtoBufSeriesUnpacked{} }
+func (m *ProtoBufSeriesUnpacked) String() string            { return proto.CompactTextString(m) }
+func (*ProtoBufSeriesUnpacked) ProtoMessage()               {}
+func (*ProtoBufSeriesUnpacked) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func (m *ProtoBufSeriesUnpacked) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ProtoBufSeriesUnpacked) GetTimestamps() []int64 {
+	if m != nil {
+		return m.Timestamps
+	}
+	return nil
+}
+
+func (m *ProtoBufSeriesUnpacked) GetValues() []float64 {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+type ProtoBufSeriesFixed struct {
+	Name             *string   `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Timestamps       []int64   `protobuf:"fixed64,2,rep,name=timestamps,packed" json:"timestamps,omitempty"`
+	Values           []float64 `protobuf:"fixed64,3,rep,name=values,packed" json:"values,omitempty"`
+	XXX_unrecognized []byte    `json:"-"`
+}
+
+func (m *ProtoBufSeriesFixed) Reset()                    { *m = ProtoBufSeriesFixed{} }
+func (m *ProtoBufSeriesFixed) String() string            { return proto.CompactTextString(m) }
+func (*ProtoBufSeriesFixed) ProtoMessage()               {}
+func (*ProtoBufSeriesFixed) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *ProtoBufSeriesFixed) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ProtoBufSeriesFixed) GetTimestamps() []int64 {
+	if m != nil {
+		return m.Timestamps
+	}
+	return nil
+}
+
+func (m *ProtoBufSeriesFixed) GetValues() []float64 {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+type ProtoBufSeriesZigzag struct {
+	Name             *string   `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Timestamps       []int64   `protobuf:"zigzag64,2,rep,name=timestamps,packed" json:"timestamps,omitempty"`
+	Values           []float64 `protobuf:"fixed64,3,rep,name=values,packed" json:"values,omitempty"`
+	XXX_unrecognized []byte    `json:"-"`
+}
+
+func (m *ProtoBufSeriesZigzag) Reset()                    { *m = ProtoBufSeriesZigzag{} }
+func (m *ProtoBufSeriesZigzag) String() string            { return proto.CompactTextString(m) }
+func (*ProtoBufSeriesZigzag) ProtoMessage()               {}
+func (*ProtoBufSeriesZigzag) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+
+func (m *ProtoBufSeriesZigzag) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ProtoBufSeriesZigzag) GetTimestamps() []int64 {
+	if m != nil {
+		return m.Timestamps
+	}
+	return nil
+}
+
+func (m *ProtoBufSeriesZigzag) GetValues() []float64 {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ProtoBufA)(nil), "goserbench.ProtoBufA")
+	proto.RegisterType((*ProtoBufPerson)(nil), "goserbench.ProtoBufPerson")
+	proto.RegisterType((*ProtoBufAddress)(nil), "goserbench.ProtoBufAddress")
+	proto.RegisterType((*ProtoBufChild)(nil), "goserbench.ProtoBufChild")
+	proto.RegisterType((*ProtoBufTaggedA)(nil), "goserbench.ProtoBufTaggedA")
+	proto.RegisterType((*ProtoBufBlob)(nil), "goserbench.ProtoBufBlob")
+	proto.RegisterType((*ProtoBufBatch)(nil), "goserbench.ProtoBufBatch")
+	proto.RegisterType((*ProtoBufSeries)(nil), "goserbench.ProtoBufSeries")
+	proto.RegisterType((*ProtoBufOptionalA)(nil), "goserbench.ProtoBufOptionalA")
+	proto.RegisterType((*ProtoBufEvent)(nil), "goserbench.ProtoBufEvent")
+	proto.RegisterType((*ProtoBufClick)(nil), "goserbench.ProtoBufClick")
+	proto.RegisterType((*ProtoBufPurchase)(nil), "goserbench.ProtoBufPurchase")
+	proto.RegisterType((*ProtoBufSignup)(nil), "goserbench.ProtoBufSignup")
+	proto.RegisterType((*ProtoBufNode)(nil), "goserbench.ProtoBufNode")
+	proto.RegisterType((*ProtoBufSeriesUnpacked)(nil), "goserbench.ProtoBufSeriesUnpacked")
+	proto.RegisterType((*ProtoBufSeriesFixed)(nil), "goserbench.ProtoBufSeriesFixed")
+	proto.RegisterType((*ProtoBufSeriesZigzag)(nil), "goserbench.ProtoBufSeriesZigzag")
 }
 
 var fileDescriptor0 = []byte{
-	// 146 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x34, 0xcb, 0x39, 0x0e, 0xc2, 0x30,
-	0x10, 0x85, 0x61, 0x65, 0x55, 0x32, 0x62, 0x93, 0xab, 0x29, 0x11, 0x15, 0x15, 0x77, 0x00, 0x71,
-	0x00, 0xae, 0x10, 0x87, 0x49, 0x6c, 0x41, 0x6c, 0xcb, 0x63, 0x17, 0xb9, 0x3d, 0x93, 0x82, 0xf2,
-	0x7d, 0xfa, 0x1f, 0x1c, 0x39, 0xc5, 0x3c, 0xa6, 0x37, 0x4d, 0xb7, 0x10, 0x7d, 0xf2, 0x0a, 0x66,
-	0xcf, 0x14, 0x35, 0xb9, 0xd1, 0x5c, 0x3e, 0xd0, 0xbf, 0x36, 0x7c, 0xe4, 0xe9, 0xae, 0x76, 0x50,
-	0xbb, 0x61, 0x21, 0x2c, 0xce, 0xe5, 0xb5, 0x57, 0x27, 0xe8, 0xb4, 0x8d, 0xc9, 0x3c, 0x87, 0x15,
-	0x4b, 0x91, 0x4a, 0xed, 0xa1, 0x09, 0xc6, 0x3b, 0xc2, 0xea, 0x1f, 0xb0, 0xd5, 0x5f, 0xeb, 0x66,
-	0xc6, 0x5a, 0xa4, 0x51, 0x07, 0x68, 0x39, 0xf8, 0xcc, 0x84, 0x8d, 0xec, 0x6e, 0x3b, 0x2c, 0xd2,
-	0xaf, 0xd8, 0xca, 0x2c, 0x7e, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe3, 0xa4, 0xa8, 0x5c, 0x8a, 0x00,
-	0x00, 0x00,
+	// 694 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x18, 0xed, 0xd8, 0x71, 0x7e, 0xbe, 0x24, 0x6d, 0xee, 0xdc, 0x7b, 0xab, 0xb9, 0xbd, 0x2c, 0xac,
+	0x91, 0x40, 0x56, 0x5b, 0x45, 0x28, 0x5d, 0x80, 0x58, 0x80, 0x1a, 0x28, 0xaa, 0x2a, 0x41, 0x23,
+	0xb5, 0x08, 0x89, 0xdd, 0xc4, 0x9e, 0x3a, 0xa3, 0x38, 0xb6, 0x99, 0x19, 0x57, 0x4d, 0xdf, 0x88,
+	0x15, 0x2f, 0xc5, 0x83, 0xa0, 0xb1, 0x93, 0xba, 0x4e, 0x52, 0x15, 0x16, 0xec, 0x12, 0xfb, 0xcc,
+	0xf9, 0xce, 0xf9, 0xce, 0x19, 0xc3, 0x8e, 0xd2, 0x32, 0xf3, 0x75, 0xc0, 0xaf, 0xfa, 0xa9, 0x4c,
+	0x74, 0x82, 0x21, 0x4c, 0x14, 0x97, 0x63, 0x1e, 0xfb, 0x13, 0x3a, 0x85, 0xd6, 0xc8, 0x3c, 0x1c,
+	0x66, 0x57, 0xc7, 0xb8, 0x03, 0xb5, 0x98, 0xcd, 0x38, 0x41, 0xae, 0xe5, 0xb5, 0x70, 0x0f, 0x9a,
+	0x63, 0x21, 0xf5, 0xe4, 0x1d, 0x9b, 0x13, 0xcb, 0xb5, 0x3c, 0x1b, 0x77, 0xc1, 0x49, 0x27, 0x49,
+	0xcc, 0x89, 0xbd, 0x04, 0x28, 0x31, 0x8e, 0x44, 0x1c, 0x2a, 0x52, 0x73, 0x2d, 0xcf, 0xc1, 0xdb,
+	0x50, 0x57, 0x69, 0x92, 0x29, 0x4e, 0x1c, 0xd7, 0xf2, 0x9a, 0xe6, 0xc0, 0x2c, 0x89, 0xf9, 0x9c,
+	0xd4, 0x5d, 0xcb, 0x43, 0xf4, 0x1b, 0x82, 0xed, 0xe5, 0xb4, 0x11, 0x97, 0x2a, 0x89, 0x7f, 0x77,
+	0xe4, 0x1d, 0xa1, 0x99, 0x87, 0xf0, 0x21, 0x34, 0x58, 0x10, 0x48, 0xae, 0x54, 0x3e, 0xb0, 0x3d,
+	0xf8, 0xbf, 0x5f, 0x7a, 0xeb, 0xdf, 0x19, 0x2b, 0x20, 0xf8, 0x00, 0x9a, 0xfe, 0x44, 0x44, 0x81,
+	0xe4, 0x31, 0xa9, 0xbb, 0xb6, 0xd7, 0x1e, 0xfc, 0xb7, 0x09, 0xfe, 0xd6, 0x60, 0xe8, 0x07, 0xd8,
+	0x59, 0x3d, 0x6f, 0xdc, 0x69, 0xc9, 0xb9, 0x5e, 0xa8, 0xed, 0x40, 0xcd, 0x17, 0xba, 0x50, 0xda,
+	0xc2, 0x6d, 0xb0, 0x6f, 0x45, 0xba, 0xd0, 0xb9, 0x03, 0x0d, 0x3f, 0xc9, 0x62, 0x2d, 0x0b, 0xa5,
+	0x2d, 0xfa, 0x1a, 0xba, 0x15, 0xfe, 0x5f, 0x31, 0x1e, 0x4a, 0x16, 0x14, 0xc6, 0x1d, 0xfa, 0x03,
+	0x95, 0x7a, 0x2e, 0x59, 0x18, 0xf2, 0xe0, 0x8f, 0xc7, 0x85, 0x8f, 0xa0, 0xa6, 0x59, 0xa8, 0x48,
+	0x23, 0xdf, 0xd5, 0xd3, 0x4d, 0xbb, 0x5a, 0x48, 0xe9, 0x5f, 0xb2, 0x50, 0x9d, 0x18, 0xbb, 0xc6,
+	0x39, 0x8b, 0x04, 0x53, 0x5c, 0x91, 0xa6, 0x6b, 0x7b, 0xad, 0xbd, 0x03, 0x68, 0x95, 0x6f, 0xdb,
+	0x60, 0x4f, 0xf9, 0x9c, 0x20, 0x17, 0x15, 0x61, 0x5e, 0xb3, 0x28, 0xe3, 0xc4, 0x32, 0x7f, 0x5f,
+	0x59, 0x2f, 0x11, 0xdd, 0x87, 0xce, 0x92, 0x7a, 0x18, 0x25, 0xe3, 0x15, 0x8b, 0x1d, 0xa8, 0x05,
+	0x4c, 0xb3, 0xdc, 0x5e, 0x87, 0xbe, 0x28, 0x57, 0x3a, 0x64, 0xda, 0x9f, 0xe0, 0x67, 0xd0, 0x90,
+	0xdc, 0x4f, 0x64, 0xa0, 0x08, 0xca, 0x25, 0xff, 0xbb, 0xb1, 0x0d, 0xf4, 0xac, 0x6c, 0xe1, 0x05,
+	0x97, 0x82, 0xab, 0x95, 0x31, 0xbb, 0x00, 0x5a, 0xcc, 0xb8, 0xd2, 0x6c, 0x96, 0x2a, 0x62, 0xb9,
+	0xb6, 0x67, 0x0f, 0xad, 0x1e, 0xc2, 0x18, 0xea, 0xb9, 0x5e, 0x45, 0x6c, 0xd7, 0xf6, 0x90, 0x79,
+	0x46, 0x15, 0xfc, 0xb5, 0xe4, 0x3a, 0x4f, 0xb5, 0x48, 0x62, 0x16, 0xdd, 0x0f, 0x06, 0xad, 0x05,
+	0x83, 0xaa, 0xc1, 0xa0, 0xb5, 0x60, 0xd0, 0x4a, 0x30, 0xa8, 0x1a, 0x0c, 0xf2, 0x10, 0xfd, 0x8e,
+	0x4a, 0xeb, 0x27, 0xd7, 0x3c, 0xd6, 0x18, 0xc0, 0x12, 0x41, 0x2e, 0xdf, 0xc6, 0xfb, 0xe0, 0xf8,
+	0x91, 0xf0, 0xa7, 0xf9, 0xb0, 0x87, 0x3a, 0x6e, 0x00, 0xa7, 0x5b, 0xf8, 0x39, 0x34, 0xd3, 0x4c,
+	0xfa, 0x13, 0xa6, 0x0a, 0x31, 0xed, 0xc1, 0x93, 0x4d, 0xf0, 0xd1, 0x02, 0x73, 0xba, 0x85, 0x0f,
+	0xa1, 0xae, 0x44, 0x18, 0x67, 0x69, 0x2e, 0xb5, 0x3d, 0xd8, 0xdb, 0x84, 0xbf, 0xc8, 0x11, 0xa7,
+	0x5b, 0xc3, 0x3a, 0xd4, 0xc6, 0x49, 0x30, 0xa7, 0x47, 0xd0, 0xad, 0x8c, 0x36, 0x2b, 0x4a, 0x59,
+	0xb8, 0xdc, 0x78, 0x0b, 0xd0, 0x4d, 0x9e, 0xaa, 0x63, 0x7e, 0xce, 0x17, 0x9d, 0x3f, 0x86, 0xde,
+	0xaa, 0x00, 0x73, 0x4e, 0x68, 0x3e, 0x2b, 0x3b, 0xff, 0x35, 0x63, 0xb1, 0x5e, 0xde, 0x42, 0x27,
+	0x5f, 0xad, 0x14, 0x7e, 0xd1, 0x79, 0x44, 0xdf, 0xc0, 0x76, 0x55, 0xd3, 0x4a, 0xd4, 0x5d, 0x70,
+	0xf8, 0x8c, 0x89, 0x68, 0x71, 0x87, 0x7b, 0xd0, 0x94, 0xfc, 0x8a, 0x4b, 0xc9, 0x65, 0x71, 0x69,
+	0xe8, 0xe7, 0xb2, 0x90, 0x1f, 0x93, 0x80, 0xaf, 0x1f, 0x5f, 0x36, 0xb8, 0xd8, 0x7c, 0xf9, 0x81,
+	0xb1, 0xf3, 0x06, 0x92, 0x4d, 0xdb, 0x31, 0x44, 0xf4, 0x0c, 0x76, 0xab, 0x25, 0xfc, 0x14, 0xa7,
+	0xcc, 0x9f, 0xf2, 0xd5, 0x2f, 0x03, 0x5e, 0x2f, 0xa3, 0xa9, 0xc7, 0xfd, 0x22, 0xd2, 0x73, 0xf8,
+	0xbb, 0xca, 0xf5, 0x5e, 0xdc, 0xf0, 0xe0, 0xd1, 0x56, 0xf7, 0x1e, 0x6c, 0xf5, 0x08, 0xfe, 0xa9,
+	0x12, 0x7e, 0x11, 0xe1, 0x2d, 0x0b, 0x1f, 0x65, 0xc4, 0x0f, 0x31, 0xfe, 0x1c, 0x00, 0x60, 0xf6,
+	0x41, 0xa9, 0x85, 0x06, 0x00, 0x00,
 }
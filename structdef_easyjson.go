@@ -101,3 +101,1115 @@ func (v *A) UnmarshalJSONEasyJSON(data []byte) error {
 func (v *A) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson_decode_go_serialization_benchmarks_A(l, v)
 }
+func easyjson_decode_go_serialization_benchmarks_Person(in *jlexer.Lexer, out *Person) {
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Name":
+			out.Name = in.String()
+		case "BirthDay":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.BirthDay).UnmarshalJSON(data))
+			}
+		case "Phone":
+			out.Phone = in.String()
+		case "Money":
+			out.Money = in.Float64()
+		case "Address":
+			easyjson_decode_go_serialization_benchmarks_Address(in, &out.Address)
+		case "Children":
+			in.Delim('[')
+			if !in.IsDelim(']') {
+				out.Children = make([]Child, 0, 4)
+			} else {
+				out.Children = nil
+			}
+			for !in.IsDelim(']') {
+				var v1 Child
+				easyjson_decode_go_serialization_benchmarks_Child(in, &v1)
+				out.Children = append(out.Children, v1)
+				in.WantComma()
+			}
+			in.Delim(']')
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+}
+func easyjson_encode_go_serialization_benchmarks_Person(out *jwriter.Writer, in *Person) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Name\":")
+	out.String(in.Name)
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"BirthDay\":")
+	out.Raw((in.BirthDay).MarshalJSON())
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Phone\":")
+	out.String(in.Phone)
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Money\":")
+	out.Float64(in.Money)
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Address\":")
+	easyjson_encode_go_serialization_benchmarks_Address(out, &in.Address)
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Children\":")
+	if in.Children == nil {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v2, v3 := range in.Children {
+			if v2 > 0 {
+				out.RawByte(',')
+			}
+			easyjson_encode_go_serialization_benchmarks_Child(out, &v3)
+		}
+		out.RawByte(']')
+	}
+	out.RawByte('}')
+}
+func (v *Person) MarshalJSONEasyJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson_encode_go_serialization_benchmarks_Person(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+func (v *Person) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson_encode_go_serialization_benchmarks_Person(w, v)
+}
+func (v *Person) UnmarshalJSONEasyJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson_decode_go_serialization_benchmarks_Person(&r, v)
+	return r.Error()
+}
+func (v *Person) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson_decode_go_serialization_benchmarks_Person(l, v)
+}
+func easyjson_decode_go_serialization_benchmarks_Address(in *jlexer.Lexer, out *Address) {
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Street":
+			out.Street = in.String()
+		case "City":
+			out.City = in.String()
+		case "Zip":
+			out.Zip = in.String()
+		case "Country":
+			out.Country = in.String()
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+}
+func easyjson_encode_go_serialization_benchmarks_Address(out *jwriter.Writer, in *Address) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Street\":")
+	out.String(in.Street)
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"City\":")
+	out.String(in.City)
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Zip\":")
+	out.String(in.Zip)
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Country\":")
+	out.String(in.Country)
+	out.RawByte('}')
+}
+func (v *Address) MarshalJSONEasyJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson_encode_go_serialization_benchmarks_Address(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+func (v *Address) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson_encode_go_serialization_benchmarks_Address(w, v)
+}
+func (v *Address) UnmarshalJSONEasyJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson_decode_go_serialization_benchmarks_Address(&r, v)
+	return r.Error()
+}
+func (v *Address) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson_decode_go_serialization_benchmarks_Address(l, v)
+}
+func easyjson_decode_go_serialization_benchmarks_Child(in *jlexer.Lexer, out *Child) {
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Name":
+			out.Name = in.String()
+		case "BirthDay":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.BirthDay).UnmarshalJSON(data))
+			}
+		case "Grade":
+			out.Grade = in.Int()
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+}
+func easyjson_encode_go_serialization_benchmarks_Child(out *jwriter.Writer, in *Child) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Name\":")
+	out.String(in.Name)
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"BirthDay\":")
+	out.Raw((in.BirthDay).MarshalJSON())
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Grade\":")
+	out.Int(in.Grade)
+	out.RawByte('}')
+}
+func (v *Child) MarshalJSONEasyJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson_encode_go_serialization_benchmarks_Child(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+func (v *Child) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson_encode_go_serialization_benchmarks_Child(w, v)
+}
+func (v *Child) UnmarshalJSONEasyJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson_decode_go_serialization_benchmarks_Child(&r, v)
+	return r.Error()
+}
+func (v *Child) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson_decode_go_serialization_benchmarks_Child(l, v)
+}
+func easyjson_decode_go_serialization_benchmarks_TaggedA(in *jlexer.Lexer, out *TaggedA) {
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Name":
+			out.Name = in.String()
+		case "BirthDay":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.BirthDay).UnmarshalJSON(data))
+			}
+		case "Phone":
+			out.Phone = in.String()
+		case "Siblings":
+			out.Siblings = in.Int()
+		case "Spouse":
+			out.Spouse = in.Bool()
+		case "Money":
+			out.Money = in.Float64()
+		case "Tags":
+			in.Delim('{')
+			if !in.IsDelim('}') {
+				out.Tags = make(map[string]string)
+			} else {
+				out.Tags = nil
+			}
+			for !in.IsDelim('}') {
+				key := string(in.String())
+				in.WantColon()
+				var v1 string
+				v1 = string(in.String())
+				(out.Tags)[key] = v1
+				in.WantComma()
+			}
+			in.Delim('}')
+		case "Aliases":
+			in.Delim('[')
+			if !in.IsDelim(']') {
+				out.Aliases = make([]string, 0, 4)
+			} else {
+				out.Aliases = nil
+			}
+			for !in.IsDelim(']') {
+				var v2 string
+				v2 = string(in.String())
+				out.Aliases = append(out.Aliases, v2)
+				in.WantComma()
+			}
+			in.Delim(']')
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+}
+func easyjson_encode_go_serialization_benchmarks_TaggedA(out *jwriter.Writer, in *TaggedA) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Name\":")
+	out.String(in.Name)
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"BirthDay\":")
+	out.Raw((in.BirthDay).MarshalJSON())
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Phone\":")
+	out.String(in.Phone)
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Siblings\":")
+	out.Int(in.Siblings)
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Spouse\":")
+	out.Bool(in.Spouse)
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Money\":")
+	out.Float64(in.Money)
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Tags\":")
+	if in.Tags == nil {
+		out.RawString(`null`)
+	} else {
+		out.RawByte('{')
+		v3First := true
+		for v3Name, v3Value := range in.Tags {
+			if !v3First {
+				out.RawByte(',')
+			}
+			v3First = false
+			out.String(string(v3Name))
+			out.RawByte(':')
+			out.String(string(v3Value))
+		}
+		out.RawByte('}')
+	}
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Aliases\":")
+	if in.Aliases == nil {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v4, v5 := range in.Aliases {
+			if v4 > 0 {
+				out.RawByte(',')
+			}
+			out.String(string(v5))
+		}
+		out.RawByte(']')
+	}
+	out.RawByte('}')
+}
+func (v *TaggedA) MarshalJSONEasyJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson_encode_go_serialization_benchmarks_TaggedA(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+func (v *TaggedA) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson_encode_go_serialization_benchmarks_TaggedA(w, v)
+}
+func (v *TaggedA) UnmarshalJSONEasyJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson_decode_go_serialization_benchmarks_TaggedA(&r, v)
+	return r.Error()
+}
+func (v *TaggedA) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson_decode_go_serialization_benchmarks_TaggedA(l, v)
+}
+func easyjson_decode_go_serialization_benchmarks_Blob(in *jlexer.Lexer, out *Blob) {
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Name":
+			out.Name = in.String()
+		case "Data":
+			out.Data = in.Bytes()
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+}
+func easyjson_encode_go_serialization_benchmarks_Blob(out *jwriter.Writer, in *Blob) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Name\":")
+	out.String(in.Name)
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Data\":")
+	out.Base64Bytes(in.Data)
+	out.RawByte('}')
+}
+func (v *Blob) MarshalJSONEasyJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson_encode_go_serialization_benchmarks_Blob(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+func (v *Blob) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson_encode_go_serialization_benchmarks_Blob(w, v)
+}
+func (v *Blob) UnmarshalJSONEasyJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson_decode_go_serialization_benchmarks_Blob(&r, v)
+	return r.Error()
+}
+func (v *Blob) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson_decode_go_serialization_benchmarks_Blob(l, v)
+}
+func easyjson_decode_go_serialization_benchmarks_Batch(in *jlexer.Lexer, out *Batch) {
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Records":
+			in.Delim('[')
+			if !in.IsDelim(']') {
+				out.Records = make([]A, 0, 4)
+			} else {
+				out.Records = nil
+			}
+			for !in.IsDelim(']') {
+				var v1 A
+				easyjson_decode_go_serialization_benchmarks_A(in, &v1)
+				out.Records = append(out.Records, v1)
+				in.WantComma()
+			}
+			in.Delim(']')
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+}
+func easyjson_encode_go_serialization_benchmarks_Batch(out *jwriter.Writer, in *Batch) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Records\":")
+	if in.Records == nil {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v2, v3 := range in.Records {
+			if v2 > 0 {
+				out.RawByte(',')
+			}
+			easyjson_encode_go_serialization_benchmarks_A(out, &v3)
+		}
+		out.RawByte(']')
+	}
+	out.RawByte('}')
+}
+func (v *Batch) MarshalJSONEasyJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson_encode_go_serialization_benchmarks_Batch(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+func (v *Batch) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson_encode_go_serialization_benchmarks_Batch(w, v)
+}
+func (v *Batch) UnmarshalJSONEasyJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson_decode_go_serialization_benchmarks_Batch(&r, v)
+	return r.Error()
+}
+func (v *Batch) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson_decode_go_serialization_benchmarks_Batch(l, v)
+}
+func easyjson_decode_go_serialization_benchmarks_Series(in *jlexer.Lexer, out *Series) {
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Name":
+			out.Name = in.String()
+		case "Timestamps":
+			in.Delim('[')
+			if !in.IsDelim(']') {
+				out.Timestamps = make([]int64, 0, 8)
+			} else {
+				out.Timestamps = nil
+			}
+			for !in.IsDelim(']') {
+				var v1 int64
+				v1 = int64(in.Int64())
+				out.Timestamps = append(out.Timestamps, v1)
+				in.WantComma()
+			}
+			in.Delim(']')
+		case "Values":
+			in.Delim('[')
+			if !in.IsDelim(']') {
+				out.Values = make([]float64, 0, 8)
+			} else {
+				out.Values = nil
+			}
+			for !in.IsDelim(']') {
+				var v1 float64
+				v1 = float64(in.Float64())
+				out.Values = append(out.Values, v1)
+				in.WantComma()
+			}
+			in.Delim(']')
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+}
+func easyjson_encode_go_serialization_benchmarks_Series(out *jwriter.Writer, in *Series) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Name\":")
+	out.String(in.Name)
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Timestamps\":")
+	if in.Timestamps == nil {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v4, v5 := range in.Timestamps {
+			if v4 > 0 {
+				out.RawByte(',')
+			}
+			out.Int64(int64(v5))
+		}
+		out.RawByte(']')
+	}
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Values\":")
+	if in.Values == nil {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v4, v5 := range in.Values {
+			if v4 > 0 {
+				out.RawByte(',')
+			}
+			out.Float64(float64(v5))
+		}
+		out.RawByte(']')
+	}
+	out.RawByte('}')
+}
+func (v *Series) MarshalJSONEasyJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson_encode_go_serialization_benchmarks_Series(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+func (v *Series) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson_encode_go_serialization_benchmarks_Series(w, v)
+}
+func (v *Series) UnmarshalJSONEasyJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson_decode_go_serialization_benchmarks_Series(&r, v)
+	return r.Error()
+}
+func (v *Series) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson_decode_go_serialization_benchmarks_Series(l, v)
+}
+func easyjson_decode_go_serialization_benchmarks_OmitEmptyA(in *jlexer.Lexer, out *OmitEmptyA) {
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Name":
+			out.Name = in.String()
+		case "BirthDay":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.BirthDay).UnmarshalJSON(data))
+			}
+		case "Phone":
+			out.Phone = in.String()
+		case "Siblings":
+			out.Siblings = in.Int()
+		case "Spouse":
+			out.Spouse = in.Bool()
+		case "Money":
+			out.Money = in.Float64()
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+}
+func easyjson_encode_go_serialization_benchmarks_OmitEmptyA(out *jwriter.Writer, in *OmitEmptyA) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Name != "" {
+		if !first {
+			out.RawByte(',')
+		}
+		first = false
+		out.RawString("\"Name\":")
+		out.String(in.Name)
+	}
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"BirthDay\":")
+	out.Raw((in.BirthDay).MarshalJSON())
+	if in.Phone != "" {
+		if !first {
+			out.RawByte(',')
+		}
+		first = false
+		out.RawString("\"Phone\":")
+		out.String(in.Phone)
+	}
+	if in.Siblings != 0 {
+		if !first {
+			out.RawByte(',')
+		}
+		first = false
+		out.RawString("\"Siblings\":")
+		out.Int(in.Siblings)
+	}
+	if in.Spouse {
+		if !first {
+			out.RawByte(',')
+		}
+		first = false
+		out.RawString("\"Spouse\":")
+		out.Bool(in.Spouse)
+	}
+	if in.Money != 0 {
+		if !first {
+			out.RawByte(',')
+		}
+		first = false
+		out.RawString("\"Money\":")
+		out.Float64(in.Money)
+	}
+	out.RawByte('}')
+}
+func (v *OmitEmptyA) MarshalJSONEasyJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson_encode_go_serialization_benchmarks_OmitEmptyA(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+func (v *OmitEmptyA) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson_encode_go_serialization_benchmarks_OmitEmptyA(w, v)
+}
+func (v *OmitEmptyA) UnmarshalJSONEasyJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson_decode_go_serialization_benchmarks_OmitEmptyA(&r, v)
+	return r.Error()
+}
+func (v *OmitEmptyA) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson_decode_go_serialization_benchmarks_OmitEmptyA(l, v)
+}
+func easyjson_decode_go_serialization_benchmarks_Click(in *jlexer.Lexer, out *Click) {
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Page":
+			out.Page = in.String()
+		case "X":
+			out.X = in.Int()
+		case "Y":
+			out.Y = in.Int()
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+}
+func easyjson_encode_go_serialization_benchmarks_Click(out *jwriter.Writer, in *Click) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Page\":")
+	out.String(in.Page)
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"X\":")
+	out.Int(in.X)
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Y\":")
+	out.Int(in.Y)
+	out.RawByte('}')
+}
+func (v *Click) MarshalJSONEasyJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson_encode_go_serialization_benchmarks_Click(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+func (v *Click) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson_encode_go_serialization_benchmarks_Click(w, v)
+}
+func (v *Click) UnmarshalJSONEasyJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson_decode_go_serialization_benchmarks_Click(&r, v)
+	return r.Error()
+}
+func (v *Click) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson_decode_go_serialization_benchmarks_Click(l, v)
+}
+func easyjson_decode_go_serialization_benchmarks_Purchase(in *jlexer.Lexer, out *Purchase) {
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Item":
+			out.Item = in.String()
+		case "Quantity":
+			out.Quantity = in.Int()
+		case "Price":
+			out.Price = in.Float64()
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+}
+func easyjson_encode_go_serialization_benchmarks_Purchase(out *jwriter.Writer, in *Purchase) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Item\":")
+	out.String(in.Item)
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Quantity\":")
+	out.Int(in.Quantity)
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Price\":")
+	out.Float64(in.Price)
+	out.RawByte('}')
+}
+func (v *Purchase) MarshalJSONEasyJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson_encode_go_serialization_benchmarks_Purchase(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+func (v *Purchase) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson_encode_go_serialization_benchmarks_Purchase(w, v)
+}
+func (v *Purchase) UnmarshalJSONEasyJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson_decode_go_serialization_benchmarks_Purchase(&r, v)
+	return r.Error()
+}
+func (v *Purchase) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson_decode_go_serialization_benchmarks_Purchase(l, v)
+}
+func easyjson_decode_go_serialization_benchmarks_Signup(in *jlexer.Lexer, out *Signup) {
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Name":
+			out.Name = in.String()
+		case "Email":
+			out.Email = in.String()
+		case "Referrer":
+			out.Referrer = in.String()
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+}
+func easyjson_encode_go_serialization_benchmarks_Signup(out *jwriter.Writer, in *Signup) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Name\":")
+	out.String(in.Name)
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Email\":")
+	out.String(in.Email)
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Referrer\":")
+	out.String(in.Referrer)
+	out.RawByte('}')
+}
+func (v *Signup) MarshalJSONEasyJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson_encode_go_serialization_benchmarks_Signup(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+func (v *Signup) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson_encode_go_serialization_benchmarks_Signup(w, v)
+}
+func (v *Signup) UnmarshalJSONEasyJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson_decode_go_serialization_benchmarks_Signup(&r, v)
+	return r.Error()
+}
+func (v *Signup) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson_decode_go_serialization_benchmarks_Signup(l, v)
+}
+func easyjson_decode_go_serialization_benchmarks_EventEnvelope(in *jlexer.Lexer, out *EventEnvelope) {
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ID":
+			out.ID = in.Int64()
+		case "Click":
+			if out.Click == nil {
+				out.Click = new(Click)
+			}
+			easyjson_decode_go_serialization_benchmarks_Click(in, out.Click)
+		case "Purchase":
+			if out.Purchase == nil {
+				out.Purchase = new(Purchase)
+			}
+			easyjson_decode_go_serialization_benchmarks_Purchase(in, out.Purchase)
+		case "Signup":
+			if out.Signup == nil {
+				out.Signup = new(Signup)
+			}
+			easyjson_decode_go_serialization_benchmarks_Signup(in, out.Signup)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+}
+func easyjson_encode_go_serialization_benchmarks_EventEnvelope(out *jwriter.Writer, in *EventEnvelope) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"ID\":")
+	out.Int64(in.ID)
+	if in.Click != nil {
+		if !first {
+			out.RawByte(',')
+		}
+		first = false
+		out.RawString("\"Click\":")
+		easyjson_encode_go_serialization_benchmarks_Click(out, in.Click)
+	}
+	if in.Purchase != nil {
+		if !first {
+			out.RawByte(',')
+		}
+		first = false
+		out.RawString("\"Purchase\":")
+		easyjson_encode_go_serialization_benchmarks_Purchase(out, in.Purchase)
+	}
+	if in.Signup != nil {
+		if !first {
+			out.RawByte(',')
+		}
+		first = false
+		out.RawString("\"Signup\":")
+		easyjson_encode_go_serialization_benchmarks_Signup(out, in.Signup)
+	}
+	out.RawByte('}')
+}
+func (v *EventEnvelope) MarshalJSONEasyJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson_encode_go_serialization_benchmarks_EventEnvelope(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+func (v *EventEnvelope) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson_encode_go_serialization_benchmarks_EventEnvelope(w, v)
+}
+func (v *EventEnvelope) UnmarshalJSONEasyJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson_decode_go_serialization_benchmarks_EventEnvelope(&r, v)
+	return r.Error()
+}
+func (v *EventEnvelope) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson_decode_go_serialization_benchmarks_EventEnvelope(l, v)
+}
+func easyjson_decode_go_serialization_benchmarks_Node(in *jlexer.Lexer, out *Node) {
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Name":
+			out.Name = in.String()
+		case "Value":
+			out.Value = in.Int64()
+		case "Children":
+			in.Delim('[')
+			if !in.IsDelim(']') {
+				out.Children = make([]Node, 0, 4)
+			} else {
+				out.Children = nil
+			}
+			for !in.IsDelim(']') {
+				var v1 Node
+				easyjson_decode_go_serialization_benchmarks_Node(in, &v1)
+				out.Children = append(out.Children, v1)
+				in.WantComma()
+			}
+			in.Delim(']')
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+}
+func easyjson_encode_go_serialization_benchmarks_Node(out *jwriter.Writer, in *Node) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Name\":")
+	out.String(in.Name)
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Value\":")
+	out.Int64(in.Value)
+	if !first {
+		out.RawByte(',')
+	}
+	first = false
+	out.RawString("\"Children\":")
+	if in.Children == nil {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v2, v3 := range in.Children {
+			if v2 > 0 {
+				out.RawByte(',')
+			}
+			easyjson_encode_go_serialization_benchmarks_Node(out, &v3)
+		}
+		out.RawByte(']')
+	}
+	out.RawByte('}')
+}
+func (v *Node) MarshalJSONEasyJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson_encode_go_serialization_benchmarks_Node(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+func (v *Node) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson_encode_go_serialization_benchmarks_Node(w, v)
+}
+func (v *Node) UnmarshalJSONEasyJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson_decode_go_serialization_benchmarks_Node(&r, v)
+	return r.Error()
+}
+func (v *Node) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson_decode_go_serialization_benchmarks_Node(l, v)
+}
@@ -10,3 +10,62 @@ type XDRA struct {
 	Spouse   bool
 	Money    uint64
 }
+
+type XDRPerson struct {
+	Name     string
+	BirthDay int64
+	Phone    string
+	Money    uint64
+	Address  XDRAddress
+	Children []XDRChild
+}
+
+type XDRAddress struct {
+	Street  string
+	City    string
+	Zip     string
+	Country string
+}
+
+type XDRChild struct {
+	Name     string
+	BirthDay int64
+	Grade    int32
+}
+
+type XDRTaggedA struct {
+	Name     string
+	BirthDay int64
+	Phone    string
+	Siblings int32
+	Spouse   bool
+	Money    uint64
+	Tags     []XDRTag
+	Aliases  []string
+}
+
+type XDRTag struct {
+	Key   string
+	Value string
+}
+
+type XDRBlob struct {
+	Name string
+	Data []byte
+}
+
+type XDRBatch struct {
+	Records []XDRA
+}
+
+type XDRSeries struct {
+	Name       string
+	Timestamps []int64
+	Values     []uint64
+}
+
+type XDRNode struct {
+	Name     string
+	Value    int64
+	Children []XDRNode
+}
@@ -90,3 +90,774 @@ func (o *XDRA) UnmarshalXDRFrom(u *xdr.Unmarshaller) error {
 	o.Money = u.UnmarshalUint64()
 	return u.Error
 }
+
+/*
+
+XDRPerson Structure:
+
+ 0                   1                   2                   3
+ 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+/                                                               /
+\                  Name (length + padded data)                  \
+/                                                               /
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+|                                                               |
++                      Birth Day (64 bits)                      +
+|                                                               |
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+/                                                               /
+\                 Phone (length + padded data)                  \
+/                                                               /
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+|                                                               |
++                        Money (64 bits)                        +
+|                                                               |
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+/                                                               /
+\                     XDRAddress Structure                      \
+/                                                               /
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+|                      Number of Children                       |
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+/                                                               /
+\               Zero or more XDRChild Structures                \
+/                                                               /
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+
+
+struct XDRPerson {
+	string Name<>;
+	hyper BirthDay;
+	string Phone<>;
+	unsigned hyper Money;
+	XDRAddress Address;
+	XDRChild Children<>;
+}
+
+*/
+
+func (o XDRPerson) XDRSize() int {
+	return 4 + len(o.Name) + xdr.Padding(len(o.Name)) + 8 +
+		4 + len(o.Phone) + xdr.Padding(len(o.Phone)) + 8 +
+		o.Address.XDRSize() +
+		4 + xdr.SizeOfSlice(o.Children)
+}
+
+func (o XDRPerson) MarshalXDR() ([]byte, error) {
+	buf := make([]byte, o.XDRSize())
+	m := &xdr.Marshaller{Data: buf}
+	return buf, o.MarshalXDRInto(m)
+}
+
+func (o XDRPerson) MustMarshalXDR() []byte {
+	bs, err := o.MarshalXDR()
+	if err != nil {
+		panic(err)
+	}
+	return bs
+}
+
+func (o XDRPerson) MarshalXDRInto(m *xdr.Marshaller) error {
+	m.MarshalString(o.Name)
+	m.MarshalUint64(uint64(o.BirthDay))
+	m.MarshalString(o.Phone)
+	m.MarshalUint64(o.Money)
+	if err := o.Address.MarshalXDRInto(m); err != nil {
+		return err
+	}
+	m.MarshalUint32(uint32(len(o.Children)))
+	for i := range o.Children {
+		if err := o.Children[i].MarshalXDRInto(m); err != nil {
+			return err
+		}
+	}
+	return m.Error
+}
+
+func (o *XDRPerson) UnmarshalXDR(bs []byte) error {
+	u := &xdr.Unmarshaller{Data: bs}
+	return o.UnmarshalXDRFrom(u)
+}
+func (o *XDRPerson) UnmarshalXDRFrom(u *xdr.Unmarshaller) error {
+	o.Name = u.UnmarshalString()
+	o.BirthDay = int64(u.UnmarshalUint64())
+	o.Phone = u.UnmarshalString()
+	o.Money = u.UnmarshalUint64()
+	(&o.Address).UnmarshalXDRFrom(u)
+	_ChildrenSize := int(u.UnmarshalUint32())
+	if _ChildrenSize < 0 {
+		return xdr.ElementSizeExceeded("Children", _ChildrenSize, 0)
+	} else if _ChildrenSize == 0 {
+		o.Children = nil
+	} else {
+		if _ChildrenSize <= len(o.Children) {
+			o.Children = o.Children[:_ChildrenSize]
+		} else {
+			o.Children = make([]XDRChild, _ChildrenSize)
+		}
+		for i := range o.Children {
+			(&o.Children[i]).UnmarshalXDRFrom(u)
+		}
+	}
+	return u.Error
+}
+
+/*
+
+XDRAddress Structure:
+
+ 0                   1                   2                   3
+ 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+/                                                               /
+\                 Street (length + padded data)                 \
+/                                                               /
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+/                                                               /
+\                  City (length + padded data)                  \
+/                                                               /
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+/                                                               /
+\                  Zip (length + padded data)                   \
+/                                                               /
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+/                                                               /
+\                Country (length + padded data)                 \
+/                                                               /
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+
+
+struct XDRAddress {
+	string Street<>;
+	string City<>;
+	string Zip<>;
+	string Country<>;
+}
+
+*/
+
+func (o XDRAddress) XDRSize() int {
+	return 4 + len(o.Street) + xdr.Padding(len(o.Street)) +
+		4 + len(o.City) + xdr.Padding(len(o.City)) +
+		4 + len(o.Zip) + xdr.Padding(len(o.Zip)) +
+		4 + len(o.Country) + xdr.Padding(len(o.Country))
+}
+
+func (o XDRAddress) MarshalXDR() ([]byte, error) {
+	buf := make([]byte, o.XDRSize())
+	m := &xdr.Marshaller{Data: buf}
+	return buf, o.MarshalXDRInto(m)
+}
+
+func (o XDRAddress) MustMarshalXDR() []byte {
+	bs, err := o.MarshalXDR()
+	if err != nil {
+		panic(err)
+	}
+	return bs
+}
+
+func (o XDRAddress) MarshalXDRInto(m *xdr.Marshaller) error {
+	m.MarshalString(o.Street)
+	m.MarshalString(o.City)
+	m.MarshalString(o.Zip)
+	m.MarshalString(o.Country)
+	return m.Error
+}
+
+func (o *XDRAddress) UnmarshalXDR(bs []byte) error {
+	u := &xdr.Unmarshaller{Data: bs}
+	return o.UnmarshalXDRFrom(u)
+}
+func (o *XDRAddress) UnmarshalXDRFrom(u *xdr.Unmarshaller) error {
+	o.Street = u.UnmarshalString()
+	o.City = u.UnmarshalString()
+	o.Zip = u.UnmarshalString()
+	o.Country = u.UnmarshalString()
+	return u.Error
+}
+
+/*
+
+XDRChild Structure:
+
+ 0                   1                   2                   3
+ 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+/                                                               /
+\                  Name (length + padded data)                  \
+/                                                               /
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+|                                                               |
++                      Birth Day (64 bits)                      +
+|                                                               |
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+|                             Grade                             |
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+
+
+struct XDRChild {
+	string Name<>;
+	hyper BirthDay;
+	int Grade;
+}
+
+*/
+
+func (o XDRChild) XDRSize() int {
+	return 4 + len(o.Name) + xdr.Padding(len(o.Name)) + 8 + 4
+}
+
+func (o XDRChild) MarshalXDR() ([]byte, error) {
+	buf := make([]byte, o.XDRSize())
+	m := &xdr.Marshaller{Data: buf}
+	return buf, o.MarshalXDRInto(m)
+}
+
+func (o XDRChild) MustMarshalXDR() []byte {
+	bs, err := o.MarshalXDR()
+	if err != nil {
+		panic(err)
+	}
+	return bs
+}
+
+func (o XDRChild) MarshalXDRInto(m *xdr.Marshaller) error {
+	m.MarshalString(o.Name)
+	m.MarshalUint64(uint64(o.BirthDay))
+	m.MarshalUint32(uint32(o.Grade))
+	return m.Error
+}
+
+func (o *XDRChild) UnmarshalXDR(bs []byte) error {
+	u := &xdr.Unmarshaller{Data: bs}
+	return o.UnmarshalXDRFrom(u)
+}
+func (o *XDRChild) UnmarshalXDRFrom(u *xdr.Unmarshaller) error {
+	o.Name = u.UnmarshalString()
+	o.BirthDay = int64(u.UnmarshalUint64())
+	o.Grade = int32(u.UnmarshalUint32())
+	return u.Error
+}
+
+/*
+
+XDRTaggedA Structure:
+
+ 0                   1                   2                   3
+ 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+/                                                               /
+\                  Name (length + padded data)                  \
+/                                                               /
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+|                                                               |
++                      Birth Day (64 bits)                      +
+|                                                               |
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+/                                                               /
+\                 Phone (length + padded data)                  \
+/                                                               /
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+|                           Siblings                            |
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+|                      Spouse (V=0 or 1)                      |V|
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+|                                                               |
++                        Money (64 bits)                        +
+|                                                               |
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+|                        Number of Tags                         |
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+/                                                               /
+\                Zero or more XDRTag Structures                 \
+/                                                               /
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+|                       Number of Aliases                       |
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+/                                                               /
+\                Aliases (length + padded data)                 \
+/                                                               /
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+
+
+struct XDRTaggedA {
+	string Name<>;
+	hyper BirthDay;
+	string Phone<>;
+	int Siblings;
+	bool Spouse;
+	unsigned hyper Money;
+	XDRTag Tags<>;
+	string Aliases<>;
+}
+
+*/
+
+func (o XDRTaggedA) XDRSize() int {
+	return 4 + len(o.Name) + xdr.Padding(len(o.Name)) + 8 +
+		4 + len(o.Phone) + xdr.Padding(len(o.Phone)) + 4 + 4 + 8 +
+		4 + xdr.SizeOfSlice(o.Tags) +
+		4 + xdr.SizeOfSlice(o.Aliases)
+}
+
+func (o XDRTaggedA) MarshalXDR() ([]byte, error) {
+	buf := make([]byte, o.XDRSize())
+	m := &xdr.Marshaller{Data: buf}
+	return buf, o.MarshalXDRInto(m)
+}
+
+func (o XDRTaggedA) MustMarshalXDR() []byte {
+	bs, err := o.MarshalXDR()
+	if err != nil {
+		panic(err)
+	}
+	return bs
+}
+
+func (o XDRTaggedA) MarshalXDRInto(m *xdr.Marshaller) error {
+	m.MarshalString(o.Name)
+	m.MarshalUint64(uint64(o.BirthDay))
+	m.MarshalString(o.Phone)
+	m.MarshalUint32(uint32(o.Siblings))
+	m.MarshalBool(o.Spouse)
+	m.MarshalUint64(o.Money)
+	m.MarshalUint32(uint32(len(o.Tags)))
+	for i := range o.Tags {
+		if err := o.Tags[i].MarshalXDRInto(m); err != nil {
+			return err
+		}
+	}
+	m.MarshalUint32(uint32(len(o.Aliases)))
+	for i := range o.Aliases {
+		m.MarshalString(o.Aliases[i])
+	}
+	return m.Error
+}
+
+func (o *XDRTaggedA) UnmarshalXDR(bs []byte) error {
+	u := &xdr.Unmarshaller{Data: bs}
+	return o.UnmarshalXDRFrom(u)
+}
+func (o *XDRTaggedA) UnmarshalXDRFrom(u *xdr.Unmarshaller) error {
+	o.Name = u.UnmarshalString()
+	o.BirthDay = int64(u.UnmarshalUint64())
+	o.Phone = u.UnmarshalString()
+	o.Siblings = int32(u.UnmarshalUint32())
+	o.Spouse = u.UnmarshalBool()
+	o.Money = u.UnmarshalUint64()
+	_TagsSize := int(u.UnmarshalUint32())
+	if _TagsSize < 0 {
+		return xdr.ElementSizeExceeded("Tags", _TagsSize, 0)
+	} else if _TagsSize == 0 {
+		o.Tags = nil
+	} else {
+		if _TagsSize <= len(o.Tags) {
+			o.Tags = o.Tags[:_TagsSize]
+		} else {
+			o.Tags = make([]XDRTag, _TagsSize)
+		}
+		for i := range o.Tags {
+			(&o.Tags[i]).UnmarshalXDRFrom(u)
+		}
+	}
+	_AliasesSize := int(u.UnmarshalUint32())
+	if _AliasesSize < 0 {
+		return xdr.ElementSizeExceeded("Aliases", _AliasesSize, 0)
+	} else if _AliasesSize == 0 {
+		o.Aliases = nil
+	} else {
+		if _AliasesSize <= len(o.Aliases) {
+			o.Aliases = o.Aliases[:_AliasesSize]
+		} else {
+			o.Aliases = make([]string, _AliasesSize)
+		}
+		for i := range o.Aliases {
+			o.Aliases[i] = u.UnmarshalString()
+		}
+	}
+	return u.Error
+}
+
+/*
+
+XDRTag Structure:
+
+ 0                   1                   2                   3
+ 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+/                                                               /
+\                  Key (length + padded data)                   \
+/                                                               /
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+/                                                               /
+\                 Value (length + padded data)                  \
+/                                                               /
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+
+
+struct XDRTag {
+	string Key<>;
+	string Value<>;
+}
+
+*/
+
+func (o XDRTag) XDRSize() int {
+	return 4 + len(o.Key) + xdr.Padding(len(o.Key)) +
+		4 + len(o.Value) + xdr.Padding(len(o.Value))
+}
+
+func (o XDRTag) MarshalXDR() ([]byte, error) {
+	buf := make([]byte, o.XDRSize())
+	m := &xdr.Marshaller{Data: buf}
+	return buf, o.MarshalXDRInto(m)
+}
+
+func (o XDRTag) MustMarshalXDR() []byte {
+	bs, err := o.MarshalXDR()
+	if err != nil {
+		panic(err)
+	}
+	return bs
+}
+
+func (o XDRTag) MarshalXDRInto(m *xdr.Marshaller) error {
+	m.MarshalString(o.Key)
+	m.MarshalString(o.Value)
+	return m.Error
+}
+
+func (o *XDRTag) UnmarshalXDR(bs []byte) error {
+	u := &xdr.Unmarshaller{Data: bs}
+	return o.UnmarshalXDRFrom(u)
+}
+func (o *XDRTag) UnmarshalXDRFrom(u *xdr.Unmarshaller) error {
+	o.Key = u.UnmarshalString()
+	o.Value = u.UnmarshalString()
+	return u.Error
+}
+
+/*
+
+XDRBlob Structure:
+
+ 0                   1                   2                   3
+ 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+/                                                               /
+\                  Name (length + padded data)                  \
+/                                                               /
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+/                                                               /
+\                  Data (length + padded data)                  \
+/                                                               /
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+
+
+struct XDRBlob {
+	string Name<>;
+	opaque Data<>;
+}
+
+*/
+
+func (o XDRBlob) XDRSize() int {
+	return 4 + len(o.Name) + xdr.Padding(len(o.Name)) +
+		4 + len(o.Data) + xdr.Padding(len(o.Data))
+}
+
+func (o XDRBlob) MarshalXDR() ([]byte, error) {
+	buf := make([]byte, o.XDRSize())
+	m := &xdr.Marshaller{Data: buf}
+	return buf, o.MarshalXDRInto(m)
+}
+
+func (o XDRBlob) MustMarshalXDR() []byte {
+	bs, err := o.MarshalXDR()
+	if err != nil {
+		panic(err)
+	}
+	return bs
+}
+
+func (o XDRBlob) MarshalXDRInto(m *xdr.Marshaller) error {
+	m.MarshalString(o.Name)
+	m.MarshalBytes(o.Data)
+	return m.Error
+}
+
+func (o *XDRBlob) UnmarshalXDR(bs []byte) error {
+	u := &xdr.Unmarshaller{Data: bs}
+	return o.UnmarshalXDRFrom(u)
+}
+func (o *XDRBlob) UnmarshalXDRFrom(u *xdr.Unmarshaller) error {
+	o.Name = u.UnmarshalString()
+	o.Data = u.UnmarshalBytes()
+	return u.Error
+}
+
+/*
+
+XDRBatch Structure:
+
+ 0                   1                   2                   3
+ 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+|                       Number of Records                       |
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+/                                                               /
+\                 Zero or more XDRA Structures                  \
+/                                                               /
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+
+
+struct XDRBatch {
+	XDRA Records<>;
+}
+
+*/
+
+func (o XDRBatch) XDRSize() int {
+	return 4 + xdr.SizeOfSlice(o.Records)
+}
+
+func (o XDRBatch) MarshalXDR() ([]byte, error) {
+	buf := make([]byte, o.XDRSize())
+	m := &xdr.Marshaller{Data: buf}
+	return buf, o.MarshalXDRInto(m)
+}
+
+func (o XDRBatch) MustMarshalXDR() []byte {
+	bs, err := o.MarshalXDR()
+	if err != nil {
+		panic(err)
+	}
+	return bs
+}
+
+func (o XDRBatch) MarshalXDRInto(m *xdr.Marshaller) error {
+	m.MarshalUint32(uint32(len(o.Records)))
+	for i := range o.Records {
+		if err := o.Records[i].MarshalXDRInto(m); err != nil {
+			return err
+		}
+	}
+	return m.Error
+}
+
+func (o *XDRBatch) UnmarshalXDR(bs []byte) error {
+	u := &xdr.Unmarshaller{Data: bs}
+	return o.UnmarshalXDRFrom(u)
+}
+func (o *XDRBatch) UnmarshalXDRFrom(u *xdr.Unmarshaller) error {
+	_RecordsSize := int(u.UnmarshalUint32())
+	if _RecordsSize < 0 {
+		return xdr.ElementSizeExceeded("Records", _RecordsSize, 0)
+	} else if _RecordsSize == 0 {
+		o.Records = nil
+	} else {
+		if _RecordsSize <= len(o.Records) {
+			o.Records = o.Records[:_RecordsSize]
+		} else {
+			o.Records = make([]XDRA, _RecordsSize)
+		}
+		for i := range o.Records {
+			(&o.Records[i]).UnmarshalXDRFrom(u)
+		}
+	}
+	return u.Error
+}
+
+/*
+
+XDRSeries Structure:
+
+ 0                   1                   2                   3
+ 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+/                                                               /
+\                  Name (length + padded data)                  \
+/                                                               /
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+|                     Number of Timestamps                      |
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+|                                                               |
++               Zero or more Timestamps (64 bits)               +
+|                                                               |
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+|                       Number of Values                        |
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+|                                                               |
++                 Zero or more Values (64 bits)                 +
+|                                                               |
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+
+
+struct XDRSeries {
+	string Name<>;
+	hyper Timestamps<>;
+	unsigned hyper Values<>;
+}
+
+*/
+
+func (o XDRSeries) XDRSize() int {
+	return 4 + len(o.Name) + xdr.Padding(len(o.Name)) +
+		4 + len(o.Timestamps)*8 +
+		4 + len(o.Values)*8
+}
+
+func (o XDRSeries) MarshalXDR() ([]byte, error) {
+	buf := make([]byte, o.XDRSize())
+	m := &xdr.Marshaller{Data: buf}
+	return buf, o.MarshalXDRInto(m)
+}
+
+func (o XDRSeries) MustMarshalXDR() []byte {
+	bs, err := o.MarshalXDR()
+	if err != nil {
+		panic(err)
+	}
+	return bs
+}
+
+func (o XDRSeries) MarshalXDRInto(m *xdr.Marshaller) error {
+	m.MarshalString(o.Name)
+	m.MarshalUint32(uint32(len(o.Timestamps)))
+	for i := range o.Timestamps {
+		m.MarshalUint64(uint64(o.Timestamps[i]))
+	}
+	m.MarshalUint32(uint32(len(o.Values)))
+	for i := range o.Values {
+		m.MarshalUint64(o.Values[i])
+	}
+	return m.Error
+}
+
+func (o *XDRSeries) UnmarshalXDR(bs []byte) error {
+	u := &xdr.Unmarshaller{Data: bs}
+	return o.UnmarshalXDRFrom(u)
+}
+func (o *XDRSeries) UnmarshalXDRFrom(u *xdr.Unmarshaller) error {
+	o.Name = u.UnmarshalString()
+	_TimestampsSize := int(u.UnmarshalUint32())
+	if _TimestampsSize < 0 {
+		return xdr.ElementSizeExceeded("Timestamps", _TimestampsSize, 0)
+	} else if _TimestampsSize == 0 {
+		o.Timestamps = nil
+	} else {
+		if _TimestampsSize <= len(o.Timestamps) {
+			o.Timestamps = o.Timestamps[:_TimestampsSize]
+		} else {
+			o.Timestamps = make([]int64, _TimestampsSize)
+		}
+		for i := range o.Timestamps {
+			o.Timestamps[i] = int64(u.UnmarshalUint64())
+		}
+	}
+	_ValuesSize := int(u.UnmarshalUint32())
+	if _ValuesSize < 0 {
+		return xdr.ElementSizeExceeded("Values", _ValuesSize, 0)
+	} else if _ValuesSize == 0 {
+		o.Values = nil
+	} else {
+		if _ValuesSize <= len(o.Values) {
+			o.Values = o.Values[:_ValuesSize]
+		} else {
+			o.Values = make([]uint64, _ValuesSize)
+		}
+		for i := range o.Values {
+			o.Values[i] = u.UnmarshalUint64()
+		}
+	}
+	return u.Error
+}
+
+/*
+
+XDRNode Structure:
+
+ 0                   1                   2                   3
+ 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+/                                                               /
+\                  Name (length + padded data)                  \
+/                                                               /
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+|                                                               |
++                        Value (64 bits)                        +
+|                                                               |
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+|                      Number of Children                       |
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+/                                                               /
+\                Zero or more XDRNode Structures                \
+/                                                               /
++-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+
+
+struct XDRNode {
+	string Name<>;
+	hyper Value;
+	XDRNode Children<>;
+}
+
+*/
+
+func (o XDRNode) XDRSize() int {
+	return 4 + len(o.Name) + xdr.Padding(len(o.Name)) + 8 +
+		4 + xdr.SizeOfSlice(o.Children)
+}
+
+func (o XDRNode) MarshalXDR() ([]byte, error) {
+	buf := make([]byte, o.XDRSize())
+	m := &xdr.Marshaller{Data: buf}
+	return buf, o.MarshalXDRInto(m)
+}
+
+func (o XDRNode) MustMarshalXDR() []byte {
+	bs, err := o.MarshalXDR()
+	if err != nil {
+		panic(err)
+	}
+	return bs
+}
+
+func (o XDRNode) MarshalXDRInto(m *xdr.Marshaller) error {
+	m.MarshalString(o.Name)
+	m.MarshalUint64(uint64(o.Value))
+	m.MarshalUint32(uint32(len(o.Children)))
+	for i := range o.Children {
+		if err := o.Children[i].MarshalXDRInto(m); err != nil {
+			return err
+		}
+	}
+	return m.Error
+}
+
+func (o *XDRNode) UnmarshalXDR(bs []byte) error {
+	u := &xdr.Unmarshaller{Data: bs}
+	return o.UnmarshalXDRFrom(u)
+}
+func (o *XDRNode) UnmarshalXDRFrom(u *xdr.Unmarshaller) error {
+	o.Name = u.UnmarshalString()
+	o.Value = int64(u.UnmarshalUint64())
+	_ChildrenSize := int(u.UnmarshalUint32())
+	if _ChildrenSize < 0 {
+		return xdr.ElementSizeExceeded("Children", _ChildrenSize, 0)
+	} else if _ChildrenSize == 0 {
+		o.Children = nil
+	} else {
+		if _ChildrenSize <= len(o.Children) {
+			o.Children = o.Children[:_ChildrenSize]
+		} else {
+			o.Children = make([]XDRNode, _ChildrenSize)
+		}
+		for i := range o.Children {
+			(&o.Children[i]).UnmarshalXDRFrom(u)
+		}
+	}
+	return u.Error
+}
@@ -0,0 +1,471 @@
+package goserbench
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/google/flatbuffers/go"
+)
+
+// maxTags and maxAliases bound the collections of a generated TaggedA.
+const (
+	maxTags    = 4
+	maxAliases = 3
+)
+
+// generateTagged returns records like those of generateA, extended with a map
+// and a slice of strings, each of which may be empty.
+func generateTagged() []*TaggedA {
+	r := newRand()
+	a := make([]*TaggedA, 0, corpusSize)
+	for i := 0; i < corpusSize; i++ {
+		t := &TaggedA{
+			Name:     randString(r, 16),
+			BirthDay: randTime(r),
+			Phone:    randString(r, 10),
+			Siblings: r.Intn(5),
+			Spouse:   r.Intn(2) == 1,
+			Money:    r.Float64(),
+		}
+		if n := r.Intn(maxTags + 1); n > 0 {
+			t.Tags = make(map[string]string, n)
+			for j := 0; j < n; j++ {
+				t.Tags[randString(r, 6)] = randString(r, 12)
+			}
+		}
+		for j := r.Intn(maxAliases + 1); j > 0; j-- {
+			t.Aliases = append(t.Aliases, randString(r, 8))
+		}
+		a = append(a, t)
+	}
+	return a
+}
+
+var payloadTagged = &Payload{
+	Name:      "Tagged",
+	Generate:  func() []interface{} { return interfaces(generateTagged()) },
+	New:       func() interface{} { return &TaggedA{} },
+	Unordered: true,
+}
+
+// sortedKeys returns the keys of m in order, reusing the storage of keys.
+func sortedKeys(keys []string, m map[string]string) []string {
+	keys = keys[:0]
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// github.com/google/flatbuffers/go
+
+// FlatBufferTaggedASerializer writes the tags sorted by key, as the key
+// attribute of FlatBufferTag requires for lookups.
+type FlatBufferTaggedASerializer struct {
+	builder *flatbuffers.Builder
+	keys    []string
+	offsets []flatbuffers.UOffsetT
+}
+
+func (s *FlatBufferTaggedASerializer) Marshal(o interface{}) ([]byte, error) {
+	a := o.(*TaggedA)
+	builder := s.builder
+
+	builder.Reset()
+
+	s.keys = sortedKeys(s.keys, a.Tags)
+	s.offsets = s.offsets[:0]
+	for _, k := range s.keys {
+		key := builder.CreateString(k)
+		value := builder.CreateString(a.Tags[k])
+		FlatBufferTagStart(builder)
+		FlatBufferTagAddKey(builder, key)
+		FlatBufferTagAddValue(builder, value)
+		s.offsets = append(s.offsets, FlatBufferTagEnd(builder))
+	}
+	FlatBufferTaggedAStartTagsVector(builder, len(s.offsets))
+	for i := len(s.offsets) - 1; i >= 0; i-- {
+		builder.PrependUOffsetT(s.offsets[i])
+	}
+	tags := builder.EndVector(len(s.offsets))
+
+	s.offsets = s.offsets[:0]
+	for _, alias := range a.Aliases {
+		s.offsets = append(s.offsets, builder.CreateString(alias))
+	}
+	FlatBufferTaggedAStartAliasesVector(builder, len(s.offsets))
+	for i := len(s.offsets) - 1; i >= 0; i-- {
+		builder.PrependUOffsetT(s.offsets[i])
+	}
+	aliases := builder.EndVector(len(s.offsets))
+
+	name := builder.CreateString(a.Name)
+	phone := builder.CreateString(a.Phone)
+
+	FlatBufferTaggedAStart(builder)
+	FlatBufferTaggedAAddName(builder, name)
+	FlatBufferTaggedAAddPhone(builder, phone)
+	FlatBufferTaggedAAddBirthDay(builder, a.BirthDay.UnixNano())
+	FlatBufferTaggedAAddSiblings(builder, int32(a.Siblings))
+	var spouse byte
+	if a.Spouse {
+		spouse = byte(1)
+	}
+	FlatBufferTaggedAAddSpouse(builder, spouse)
+	FlatBufferTaggedAAddMoney(builder, a.Money)
+	FlatBufferTaggedAAddTags(builder, tags)
+	FlatBufferTaggedAAddAliases(builder, aliases)
+	builder.Finish(FlatBufferTaggedAEnd(builder))
+	return builder.Bytes[builder.Head():], nil
+}
+
+func (s *FlatBufferTaggedASerializer) Unmarshal(d []byte, i interface{}) error {
+	a := i.(*TaggedA)
+	o := FlatBufferTaggedA{}
+	o.Init(d, flatbuffers.GetUOffsetT(d))
+	a.Name = string(o.Name())
+	a.BirthDay = time.Unix(0, o.BirthDay())
+	a.Phone = string(o.Phone())
+	a.Siblings = int(o.Siblings())
+	a.Spouse = o.Spouse() == byte(1)
+	a.Money = o.Money()
+	a.Tags = nil
+	if n := o.TagsLength(); n > 0 {
+		a.Tags = make(map[string]string, n)
+		var t FlatBufferTag
+		for j := 0; j < n; j++ {
+			o.Tags(&t, j)
+			a.Tags[string(t.Key())] = string(t.Value())
+		}
+	}
+	a.Aliases = nil
+	if n := o.AliasesLength(); n > 0 {
+		a.Aliases = make([]string, n)
+		for j := range a.Aliases {
+			a.Aliases[j] = string(o.Aliases(j))
+		}
+	}
+	return nil
+}
+
+func (s *FlatBufferTaggedASerializer) String() string {
+	return "FlatBuffer"
+}
+
+// github.com/golang/protobuf
+
+var protoBufTaggedAConverter = &Converter{
+	New: func() interface{} { return &ProtoBufTaggedA{} },
+	From: func(dst, src interface{}) {
+		a := src.(*TaggedA)
+		*dst.(*ProtoBufTaggedA) = ProtoBufTaggedA{
+			Name:     proto.String(a.Name),
+			BirthDay: proto.Int64(a.BirthDay.UnixNano()),
+			Phone:    proto.String(a.Phone),
+			Siblings: proto.Int32(int32(a.Siblings)),
+			Spouse:   proto.Bool(a.Spouse),
+			Money:    proto.Float64(a.Money),
+			Tags:     a.Tags,
+			Aliases:  a.Aliases,
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*ProtoBufTaggedA)
+		*dst.(*TaggedA) = TaggedA{
+			Name:     o.GetName(),
+			BirthDay: time.Unix(0, o.GetBirthDay()),
+			Phone:    o.GetPhone(),
+			Siblings: int(o.GetSiblings()),
+			Spouse:   o.GetSpouse(),
+			Money:    o.GetMoney(),
+			Tags:     o.GetTags(),
+			Aliases:  o.GetAliases(),
+		}
+	},
+}
+
+// github.com/gogo/protobuf/proto
+
+var gogoProtoBufTaggedAConverter = &Converter{
+	New: func() interface{} { return &GogoProtoBufTaggedA{} },
+	From: func(dst, src interface{}) {
+		a := src.(*TaggedA)
+		*dst.(*GogoProtoBufTaggedA) = GogoProtoBufTaggedA{
+			Name:     a.Name,
+			BirthDay: a.BirthDay.UnixNano(),
+			Phone:    a.Phone,
+			Siblings: int32(a.Siblings),
+			Spouse:   a.Spouse,
+			Money:    a.Money,
+			Tags:     a.Tags,
+			Aliases:  a.Aliases,
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*GogoProtoBufTaggedA)
+		*dst.(*TaggedA) = TaggedA{
+			Name:     o.Name,
+			BirthDay: time.Unix(0, o.BirthDay),
+			Phone:    o.Phone,
+			Siblings: int(o.Siblings),
+			Spouse:   o.Spouse,
+			Money:    o.Money,
+			Tags:     o.Tags,
+			Aliases:  o.Aliases,
+		}
+	},
+}
+
+// Codecs without a map type carry the tags as a list of key/value pairs,
+// sorted by key so that their encodings are deterministic.
+
+// github.com/pascaldekloe/colfer
+
+var colferTaggedAConverter = &Converter{
+	New: func() interface{} { return &ColferTaggedA{} },
+	From: func(dst, src interface{}) {
+		a := src.(*TaggedA)
+		var tags []*ColferTag
+		for _, k := range sortedKeys(nil, a.Tags) {
+			tags = append(tags, &ColferTag{Key: k, Value: a.Tags[k]})
+		}
+		*dst.(*ColferTaggedA) = ColferTaggedA{
+			Name:     a.Name,
+			BirthDay: a.BirthDay,
+			Phone:    a.Phone,
+			Siblings: int32(a.Siblings),
+			Spouse:   a.Spouse,
+			Money:    a.Money,
+			Tags:     tags,
+			Aliases:  a.Aliases,
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*ColferTaggedA)
+		var tags map[string]string
+		if len(o.Tags) > 0 {
+			tags = make(map[string]string, len(o.Tags))
+			for _, t := range o.Tags {
+				tags[t.Key] = t.Value
+			}
+		}
+		*dst.(*TaggedA) = TaggedA{
+			Name:     o.Name,
+			BirthDay: o.BirthDay,
+			Phone:    o.Phone,
+			Siblings: int(o.Siblings),
+			Spouse:   o.Spouse,
+			Money:    o.Money,
+			Tags:     tags,
+			Aliases:  o.Aliases,
+		}
+	},
+}
+
+// github.com/andyleap/gencode
+
+var gencodeTaggedAConverter = &Converter{
+	New: func() interface{} { return &GencodeTaggedA{} },
+	From: func(dst, src interface{}) {
+		a := src.(*TaggedA)
+		var tags []GencodeTag
+		for _, k := range sortedKeys(nil, a.Tags) {
+			tags = append(tags, GencodeTag{Key: k, Value: a.Tags[k]})
+		}
+		*dst.(*GencodeTaggedA) = GencodeTaggedA{
+			Name:     a.Name,
+			BirthDay: a.BirthDay,
+			Phone:    a.Phone,
+			Siblings: int64(a.Siblings),
+			Spouse:   a.Spouse,
+			Money:    a.Money,
+			Tags:     tags,
+			Aliases:  a.Aliases,
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*GencodeTaggedA)
+		var tags map[string]string
+		if len(o.Tags) > 0 {
+			tags = make(map[string]string, len(o.Tags))
+			for _, t := range o.Tags {
+				tags[t.Key] = t.Value
+			}
+		}
+		*dst.(*TaggedA) = TaggedA{
+			Name:     o.Name,
+			BirthDay: o.BirthDay,
+			Phone:    o.Phone,
+			Siblings: int(o.Siblings),
+			Spouse:   o.Spouse,
+			Money:    o.Money,
+			Tags:     tags,
+			Aliases:  o.Aliases,
+		}
+	},
+}
+
+var gencodeUnsafeTaggedAConverter = &Converter{
+	New: func() interface{} { return &GencodeUnsafeTaggedA{} },
+	From: func(dst, src interface{}) {
+		a := src.(*TaggedA)
+		var tags []GencodeUnsafeTag
+		for _, k := range sortedKeys(nil, a.Tags) {
+			tags = append(tags, GencodeUnsafeTag{Key: k, Value: a.Tags[k]})
+		}
+		*dst.(*GencodeUnsafeTaggedA) = GencodeUnsafeTaggedA{
+			Name:     a.Name,
+			BirthDay: a.BirthDay.UnixNano(),
+			Phone:    a.Phone,
+			Siblings: int64(a.Siblings),
+			Spouse:   a.Spouse,
+			Money:    a.Money,
+			Tags:     tags,
+			Aliases:  a.Aliases,
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*GencodeUnsafeTaggedA)
+		var tags map[string]string
+		if len(o.Tags) > 0 {
+			tags = make(map[string]string, len(o.Tags))
+			for _, t := range o.Tags {
+				tags[t.Key] = t.Value
+			}
+		}
+		*dst.(*TaggedA) = TaggedA{
+			Name:     o.Name,
+			BirthDay: time.Unix(0, o.BirthDay),
+			Phone:    o.Phone,
+			Siblings: int(o.Siblings),
+			Spouse:   o.Spouse,
+			Money:    o.Money,
+			Tags:     tags,
+			Aliases:  o.Aliases,
+		}
+	},
+}
+
+// github.com/calmh/xdr
+
+var xdrTaggedAConverter = &Converter{
+	New: func() interface{} { return &XDRTaggedA{} },
+	From: func(dst, src interface{}) {
+		a := src.(*TaggedA)
+		var tags []XDRTag
+		for _, k := range sortedKeys(nil, a.Tags) {
+			tags = append(tags, XDRTag{Key: k, Value: a.Tags[k]})
+		}
+		*dst.(*XDRTaggedA) = XDRTaggedA{
+			Name:     a.Name,
+			BirthDay: a.BirthDay.UnixNano(),
+			Phone:    a.Phone,
+			Siblings: int32(a.Siblings),
+			Spouse:   a.Spouse,
+			Money:    math.Float64bits(a.Money),
+			Tags:     tags,
+			Aliases:  a.Aliases,
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*XDRTaggedA)
+		var tags map[string]string
+		if len(o.Tags) > 0 {
+			tags = make(map[string]string, len(o.Tags))
+			for _, t := range o.Tags {
+				tags[t.Key] = t.Value
+			}
+		}
+		*dst.(*TaggedA) = TaggedA{
+			Name:     o.Name,
+			BirthDay: time.Unix(0, o.BirthDay),
+			Phone:    o.Phone,
+			Siblings: int(o.Siblings),
+			Spouse:   o.Spouse,
+			Money:    math.Float64frombits(o.Money),
+			Tags:     tags,
+			Aliases:  o.Aliases,
+		}
+	},
+}
+
+// github.com/ikkerens/ikeapack
+
+type IkeTaggedA struct {
+	Name     string
+	BirthDay int64
+	Phone    string
+	Siblings int32
+	Spouse   bool
+	Money    uint64
+	Tags     []IkeTag
+	Aliases  []string
+}
+
+type IkeTag struct {
+	Key   string
+	Value string
+}
+
+var ikeTaggedAConverter = &Converter{
+	New: func() interface{} { return &IkeTaggedA{} },
+	From: func(dst, src interface{}) {
+		a := src.(*TaggedA)
+		var tags []IkeTag
+		for _, k := range sortedKeys(nil, a.Tags) {
+			tags = append(tags, IkeTag{Key: k, Value: a.Tags[k]})
+		}
+		*dst.(*IkeTaggedA) = IkeTaggedA{
+			Name:     a.Name,
+			BirthDay: a.BirthDay.UnixNano(),
+			Phone:    a.Phone,
+			Siblings: int32(a.Siblings),
+			Spouse:   a.Spouse,
+			Money:    math.Float64bits(a.Money),
+			Tags:     tags,
+			Aliases:  a.Aliases,
+		}
+	},
+	To: func(dst, src interface{}) {
+		o := src.(*IkeTaggedA)
+		var tags map[string]string
+		if len(o.Tags) > 0 {
+			tags = make(map[string]string, len(o.Tags))
+			for _, t := range o.Tags {
+				tags[t.Key] = t.Value
+			}
+		}
+		*dst.(*TaggedA) = TaggedA{
+			Name:     o.Name,
+			BirthDay: time.Unix(0, o.BirthDay),
+			Phone:    o.Phone,
+			Siblings: int(o.Siblings),
+			Spouse:   o.Spouse,
+			Money:    math.Float64frombits(o.Money),
+			Tags:     tags,
+			Aliases:  o.Aliases,
+		}
+	},
+}
+
+// The Tagged payload is registered for every serializer of A, under the same
+// names.
+func init() {
+	RegisterPayload(payloadTagged, map[string]Adaptation{
+		"Gotiny": {New: func() Serializer { return NewGotinySerializer(TaggedA{}) }},
+		"Gob":    {New: func() Serializer { return NewGobSerializer(TaggedA{}) }},
+		"FlatBuffers": {Converter: flatBufferConverter(func() Serializer {
+			return &FlatBufferTaggedASerializer{builder: flatbuffers.NewBuilder(0)}
+		})},
+		"Goprotobuf":    {Converter: protoBufTaggedAConverter},
+		"Gogoprotobuf":  {Converter: gogoProtoBufTaggedAConverter},
+		"Colfer":        {Converter: colferTaggedAConverter},
+		"Gencode":       {Converter: gencodeTaggedAConverter},
+		"GencodeUnsafe": {Converter: gencodeUnsafeTaggedAConverter},
+		"XDR2":          {Converter: xdrTaggedAConverter},
+		"Ikea":          {Converter: ikeTaggedAConverter},
+	})
+}
@@ -0,0 +1,116 @@
+package goserbench
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+// timeCases are the times TestTimeFidelity sends through A.BirthDay: the
+// edges of the range of int64 nanoseconds since the epoch, which several
+// converters flatten times to, and times outside UTC.
+var timeCases = []struct {
+	name string
+	t    time.Time
+}{
+	{"Zero", time.Time{}},
+	{"Epoch", time.Unix(0, 0).UTC()},
+	{"1900", time.Date(1900, 1, 1, 12, 34, 56, 789012345, time.UTC)},
+	// UnixNano only holds the years 1678 to 2262.
+	{"1600", time.Date(1600, 6, 15, 0, 0, 0, 1, time.UTC)},
+	{"2300", time.Date(2300, 6, 15, 0, 0, 0, 1, time.UTC)},
+	{"9999", time.Date(9999, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+	{"East", time.Date(2021, 6, 1, 8, 30, 0, 123456789, time.FixedZone("IST", 5*3600+1800))},
+	{"West", time.Date(2021, 6, 1, 8, 30, 0, 123456789, time.FixedZone("PDT", -7*3600))},
+	// A reading of the monotonic clock, which no format carries; decoded
+	// times are compared by their wall clock.
+	{"Now", time.Now()},
+}
+
+// timeFidelity sums up how much of want survived as got: I for the instant to
+// the second, N for the nanoseconds, L for the location, - for each lost.
+func timeFidelity(want, got time.Time) string {
+	b := []byte("---")
+	if got.Unix() == want.Unix() {
+		b[0] = 'I'
+	}
+	if got.Equal(want) {
+		b[1] = 'N'
+	}
+	wn, wo := want.Zone()
+	gn, goff := got.Zone()
+	if wn == gn && wo == goff && want.Location().String() == got.Location().String() {
+		b[2] = 'L'
+	}
+	return string(b)
+}
+
+// timeRoundTrip sends t through info in the BirthDay of an A and returns it
+// as decoded. Panics of the codec or its converter are returned as errors.
+func timeRoundTrip(info SerializerInfo, t time.Time) (got time.Time, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	a := &A{Name: "name", BirthDay: t, Phone: "phone", Siblings: 1, Spouse: true, Money: 1}
+	var in interface{} = a
+	if info.Converter != nil {
+		in = info.Converter.New()
+		info.Converter.From(in, a)
+	}
+	s := info.New()
+	b, err := s.Marshal(in)
+	if err != nil {
+		return got, err
+	}
+	o := info.newValue()
+	if err := s.Unmarshal(b, o); err != nil {
+		return got, err
+	}
+	r, ok := o.(*A)
+	if info.Converter != nil {
+		r, ok = &A{}, true
+		info.Converter.To(r, o)
+	}
+	if !ok {
+		return got, fmt.Errorf("decoded %T", o)
+	}
+	return r.BirthDay, nil
+}
+
+// TestTimeFidelity reports, for every serializer of A, which parts of the
+// times of timeCases survive a round trip. Validation compares times with
+// Equal, which ignores the location, and within the Tolerance of the
+// serializer; this shows what either hides. Run it with -v to see the table.
+func TestTimeFidelity(t *testing.T) {
+	row := func(cells ...string) string {
+		var b strings.Builder
+		fmt.Fprintf(&b, "%-22s", cells[0])
+		for _, c := range cells[1:] {
+			fmt.Fprintf(&b, " %-5s", c)
+		}
+		return strings.TrimRight(b.String(), " ")
+	}
+	header := []string{""}
+	for _, c := range timeCases {
+		header = append(header, c.name)
+	}
+	rows := []string{"I: instant to the second, N: nanoseconds, L: location", row(header...)}
+	var errs []string
+	for _, info := range serializersOfA() {
+		cells := []string{info.Name}
+		for _, c := range timeCases {
+			got, err := timeRoundTrip(info, c.t)
+			if err != nil {
+				cells = append(cells, "err")
+				errs = append(errs, fmt.Sprintf("%s %s: %s", info.Name, c.name, shorten(err.Error())))
+				continue
+			}
+			cells = append(cells, timeFidelity(c.t, got))
+		}
+		rows = append(rows, row(cells...))
+	}
+	t.Log(strings.Join(append(rows, errs...), "\n"))
+}
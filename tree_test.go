@@ -0,0 +1,477 @@
+package goserbench
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/google/flatbuffers/go"
+)
+
+var (
+	// depth and fanout shape the trees of the Tree payload: every node above
+	// depth has fanout children.
+	depth  = flag.Int("depth", int(envInt("DEPTH", 4)), "levels below the root of the Tree payload (default $DEPTH or 4)")
+	fanout = flag.Int("fanout", int(envInt("FANOUT", 3)), "children per node of the Tree payload (default $FANOUT or 3)")
+	// deep is the depth TestTreeDepth probes the serializers of Tree with.
+	deep = flag.Int("deep", int(envInt("DEEP", 0)), "depth to probe the serializers of the Tree payload with, 0 to skip (default $DEEP or 0)")
+)
+
+// probeTimeout bounds each probe of TestTreeDepth, as codecs computing the
+// size of every nested message anew take time quadratic in the depth.
+const probeTimeout = time.Minute
+
+// treeNodes returns the number of nodes of a tree of depth d and fan-out f.
+func treeNodes(d, f int) int {
+	n, level := 0, 1
+	for i := 0; i <= d; i++ {
+		n += level
+		level *= f
+	}
+	return n
+}
+
+// generateNode returns a tree of depth d with f children per node.
+func generateNode(r *rand.Rand, d, f int) Node {
+	n := Node{Name: randString(r, 8), Value: r.Int63()}
+	if d > 0 {
+		n.Children = make([]Node, f)
+		for i := range n.Children {
+			n.Children[i] = generateNode(r, d-1, f)
+		}
+	}
+	return n
+}
+
+func generateTree() []*Node {
+	r := newRand()
+	t := make([]*Node, corpusLen(32*treeNodes(*depth, *fanout)))
+	for i := range t {
+		n := generateNode(r, *depth, *fanout)
+		t[i] = &n
+	}
+	return t
+}
+
+var payloadTree = &Payload{
+	Name:     "Tree",
+	Generate: func() []interface{} { return interfaces(generateTree()) },
+	New:      func() interface{} { return &Node{} },
+}
+
+// chain returns a tree of depth d with a single node per level, built from
+// the leaf up so that building it takes no stack.
+func chain(d int) *Node {
+	n := Node{Name: "leaf"}
+	for i := 0; i < d; i++ {
+		n = Node{Name: "node", Value: int64(i), Children: []Node{n}}
+	}
+	return &n
+}
+
+// chainDepth returns the depth of a tree returned by chain.
+func chainDepth(n *Node) int {
+	d := 0
+	for len(n.Children) > 0 {
+		n = &n.Children[0]
+		d++
+	}
+	return d
+}
+
+// TestTreeDepth reports what each serializer of the Tree payload does with a
+// tree of -deep levels: decode it, refuse it with an error, or crash, and in
+// which step. As a stack overflow aborts the whole process, every serializer
+// is tried in a process of its own, this test binary rerun with $TREE_PROBE
+// naming it. The serializers that Tree skips are probed as well, to check
+// that they still cannot take it, but not those that only know A.
+func TestTreeDepth(t *testing.T) {
+	if name := os.Getenv("TREE_PROBE"); name != "" {
+		fmt.Println("treeprobe:", probeTree(name, *deep))
+		return
+	}
+	if *deep == 0 {
+		t.Skip("set -deep or $DEEP to probe the serializers of Tree")
+	}
+	for _, info := range registered() {
+		if info.payload() != payloadTree {
+			continue
+		}
+		if info.skip != "" && info.skip == info.OnlyA {
+			t.Logf("%-20s skipped: %s", info.Name, info.skip)
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), probeTimeout)
+		cmd := exec.CommandContext(ctx, os.Args[0], "-test.run=^TestTreeDepth$", fmt.Sprintf("-deep=%d", *deep))
+		cmd.Env = append(os.Environ(), "TREE_PROBE="+info.Name)
+		out, _ := cmd.CombinedOutput()
+		outcome := probeOutcome(string(out))
+		if ctx.Err() != nil {
+			outcome = fmt.Sprintf("no result within %s", probeTimeout)
+		}
+		cancel()
+		if info.skip != "" {
+			outcome = fmt.Sprintf("%s (skipped: %s)", outcome, info.skip)
+		}
+		t.Logf("%-20s %s", info.Name, outcome)
+	}
+}
+
+// probeOutcome sums up the output of a probe process. A probe that crashed
+// is reported with the last step it started, so that a converter running out
+// of stack is told apart from the codec.
+func probeOutcome(out string) string {
+	step, first, cause := "", "", ""
+	for _, line := range strings.Split(out, "\n") {
+		switch {
+		case strings.HasPrefix(line, "treeprobe: "):
+			return strings.TrimPrefix(line, "treeprobe: ")
+		case strings.HasPrefix(line, "treestep: "):
+			step = " in " + strings.TrimPrefix(line, "treestep: ")
+		case strings.HasPrefix(line, "panic: ") || strings.HasPrefix(line, "fatal error: "):
+			if cause == "" {
+				cause = line
+			}
+		case first == "" && strings.TrimSpace(line) != "":
+			first = line
+		}
+	}
+	if strings.Contains(out, "stack overflow") || strings.Contains(out, "stack exceeds") {
+		return "stack overflow" + step
+	}
+	if cause == "" {
+		cause = first
+	}
+	if cause == "" {
+		return "crashed" + step
+	}
+	return "crashed" + step + ": " + shorten(cause)
+}
+
+func TestProbeOutcome(t *testing.T) {
+	for _, c := range []struct{ out, want string }{
+		{"treestep: Marshal\ntreestep: Unmarshal\ntreeprobe: ok, 12 bytes\nPASS\n", "ok, 12 bytes"},
+		{"treestep: ConvertFromTree\nruntime: goroutine stack exceeds 1000000000-byte limit\nfatal error: stack overflow\n", "stack overflow in ConvertFromTree"},
+		{"treestep: Marshal\ntreestep: Unmarshal\n--- FAIL: TestTreeDepth (0.01s)\npanic: index out of range\n", "crashed in Unmarshal: panic: index out of range"},
+		{"signal: killed\n", "crashed: signal: killed"},
+	} {
+		if got := probeOutcome(c.out); got != c.want {
+			t.Errorf("probeOutcome(%q) = %q, want %q", c.out, got, c.want)
+		}
+	}
+}
+
+// shorten cuts s to a line of the report. Errors of decoders naming the path
+// to the failing value grow with the depth.
+func shorten(s string) string {
+	if len(s) > 80 {
+		return s[:77] + "..."
+	}
+	return s
+}
+
+// probeTree round trips a tree of depth d with the serializer name of the
+// Tree payload. It prints every step before taking it, named as the
+// sub-benchmark timing it, for probeOutcome to tell where a crash happened.
+func probeTree(name string, d int) string {
+	step := func(s string) { fmt.Println("treestep:", s) }
+	for _, info := range registered() {
+		if info.payload() != payloadTree || info.Name != name {
+			continue
+		}
+		var in interface{} = chain(d)
+		if info.Converter != nil {
+			step("ConvertFromTree")
+			in = info.Converter.New()
+			info.Converter.From(in, chain(d))
+		}
+		s := info.New()
+		step("Marshal")
+		b, err := s.Marshal(in)
+		if err != nil {
+			return "marshal error: " + shorten(err.Error())
+		}
+		step("Unmarshal")
+		o := info.newValue()
+		if err := s.Unmarshal(b, o); err != nil {
+			return "unmarshal error: " + shorten(err.Error())
+		}
+		n := &Node{}
+		if info.Converter != nil {
+			step("ConvertToTree")
+			info.Converter.To(n, o)
+		} else {
+			n = o.(*Node)
+		}
+		if got := chainDepth(n); got != d {
+			return fmt.Sprintf("decoded %d of %d levels", got, d)
+		}
+		return fmt.Sprintf("ok, %d bytes", len(b))
+	}
+	return "not registered"
+}
+
+// github.com/google/flatbuffers/go
+
+type FlatBufferTreeSerializer struct {
+	builder *flatbuffers.Builder
+}
+
+// node writes n and its children, leaves first, as tables refer to tables
+// written before them.
+func (s *FlatBufferTreeSerializer) node(n *Node) flatbuffers.UOffsetT {
+	builder := s.builder
+	var children flatbuffers.UOffsetT
+	if len(n.Children) > 0 {
+		offsets := make([]flatbuffers.UOffsetT, len(n.Children))
+		for i := range n.Children {
+			offsets[i] = s.node(&n.Children[i])
+		}
+		FlatBufferNodeStartChildrenVector(builder, len(offsets))
+		for i := len(offsets) - 1; i >= 0; i-- {
+			builder.PrependUOffsetT(offsets[i])
+		}
+		children = builder.EndVector(len(offsets))
+	}
+	name := builder.CreateString(n.Name)
+
+	FlatBufferNodeStart(builder)
+	FlatBufferNodeAddName(builder, name)
+	FlatBufferNodeAddValue(builder, n.Value)
+	if children != 0 {
+		FlatBufferNodeAddChildren(builder, children)
+	}
+	return FlatBufferNodeEnd(builder)
+}
+
+func (s *FlatBufferTreeSerializer) Marshal(o interface{}) ([]byte, error) {
+	s.builder.Reset()
+	s.builder.Finish(s.node(o.(*Node)))
+	return s.builder.Bytes[s.builder.Head():], nil
+}
+
+func flatBufferNodeTo(n *Node, o *FlatBufferNode) {
+	n.Name = string(o.Name())
+	n.Value = o.Value()
+	n.Children = nil
+	if l := o.ChildrenLength(); l > 0 {
+		n.Children = make([]Node, l)
+		var c FlatBufferNode
+		for j := range n.Children {
+			o.Children(&c, j)
+			flatBufferNodeTo(&n.Children[j], &c)
+		}
+	}
+}
+
+func (s *FlatBufferTreeSerializer) Unmarshal(d []byte, i interface{}) error {
+	o := FlatBufferNode{}
+	o.Init(d, flatbuffers.GetUOffsetT(d))
+	flatBufferNodeTo(i.(*Node), &o)
+	return nil
+}
+
+func (s *FlatBufferTreeSerializer) String() string {
+	return "FlatBuffer"
+}
+
+// The tree converters walk the tree, node by node.
+
+// github.com/golang/protobuf
+
+func protoBufNodeFrom(n *Node) *ProtoBufNode {
+	o := &ProtoBufNode{Name: proto.String(n.Name), Value: proto.Int64(n.Value)}
+	if len(n.Children) > 0 {
+		o.Children = make([]*ProtoBufNode, len(n.Children))
+		for i := range n.Children {
+			o.Children[i] = protoBufNodeFrom(&n.Children[i])
+		}
+	}
+	return o
+}
+
+func protoBufNodeTo(n *Node, o *ProtoBufNode) {
+	*n = Node{Name: o.GetName(), Value: o.GetValue()}
+	if len(o.Children) > 0 {
+		n.Children = make([]Node, len(o.Children))
+		for i, c := range o.Children {
+			protoBufNodeTo(&n.Children[i], c)
+		}
+	}
+}
+
+var protoBufNodeConverter = &Converter{
+	New:  func() interface{} { return &ProtoBufNode{} },
+	From: func(dst, src interface{}) { *dst.(*ProtoBufNode) = *protoBufNodeFrom(src.(*Node)) },
+	To:   func(dst, src interface{}) { protoBufNodeTo(dst.(*Node), src.(*ProtoBufNode)) },
+}
+
+// github.com/gogo/protobuf/proto
+
+func gogoProtoBufNodeFrom(o *GogoProtoBufNode, n *Node) {
+	*o = GogoProtoBufNode{Name: n.Name, Value: n.Value}
+	if len(n.Children) > 0 {
+		o.Children = make([]GogoProtoBufNode, len(n.Children))
+		for i := range n.Children {
+			gogoProtoBufNodeFrom(&o.Children[i], &n.Children[i])
+		}
+	}
+}
+
+func gogoProtoBufNodeTo(n *Node, o *GogoProtoBufNode) {
+	*n = Node{Name: o.Name, Value: o.Value}
+	if len(o.Children) > 0 {
+		n.Children = make([]Node, len(o.Children))
+		for i := range o.Children {
+			gogoProtoBufNodeTo(&n.Children[i], &o.Children[i])
+		}
+	}
+}
+
+var gogoProtoBufNodeConverter = &Converter{
+	New:  func() interface{} { return &GogoProtoBufNode{} },
+	From: func(dst, src interface{}) { gogoProtoBufNodeFrom(dst.(*GogoProtoBufNode), src.(*Node)) },
+	To:   func(dst, src interface{}) { gogoProtoBufNodeTo(dst.(*Node), src.(*GogoProtoBufNode)) },
+}
+
+// github.com/pascaldekloe/colfer
+
+func colferNodeFrom(n *Node) *ColferNode {
+	o := &ColferNode{Name: n.Name, Value: n.Value}
+	if len(n.Children) > 0 {
+		o.Children = make([]*ColferNode, len(n.Children))
+		for i := range n.Children {
+			o.Children[i] = colferNodeFrom(&n.Children[i])
+		}
+	}
+	return o
+}
+
+func colferNodeTo(n *Node, o *ColferNode) {
+	*n = Node{Name: o.Name, Value: o.Value}
+	if len(o.Children) > 0 {
+		n.Children = make([]Node, len(o.Children))
+		for i, c := range o.Children {
+			colferNodeTo(&n.Children[i], c)
+		}
+	}
+}
+
+var colferNodeConverter = &Converter{
+	New:  func() interface{} { return &ColferNode{} },
+	From: func(dst, src interface{}) { *dst.(*ColferNode) = *colferNodeFrom(src.(*Node)) },
+	To:   func(dst, src interface{}) { colferNodeTo(dst.(*Node), src.(*ColferNode)) },
+}
+
+// github.com/andyleap/gencode
+
+func gencodeNodeFrom(o *GencodeNode, n *Node) {
+	*o = GencodeNode{Name: n.Name, Value: n.Value}
+	if len(n.Children) > 0 {
+		o.Children = make([]GencodeNode, len(n.Children))
+		for i := range n.Children {
+			gencodeNodeFrom(&o.Children[i], &n.Children[i])
+		}
+	}
+}
+
+func gencodeNodeTo(n *Node, o *GencodeNode) {
+	*n = Node{Name: o.Name, Value: o.Value}
+	if len(o.Children) > 0 {
+		n.Children = make([]Node, len(o.Children))
+		for i := range o.Children {
+			gencodeNodeTo(&n.Children[i], &o.Children[i])
+		}
+	}
+}
+
+var gencodeNodeConverter = &Converter{
+	New:  func() interface{} { return &GencodeNode{} },
+	From: func(dst, src interface{}) { gencodeNodeFrom(dst.(*GencodeNode), src.(*Node)) },
+	To:   func(dst, src interface{}) { gencodeNodeTo(dst.(*Node), src.(*GencodeNode)) },
+}
+
+func gencodeUnsafeNodeFrom(o *GencodeUnsafeNode, n *Node) {
+	*o = GencodeUnsafeNode{Name: n.Name, Value: n.Value}
+	if len(n.Children) > 0 {
+		o.Children = make([]GencodeUnsafeNode, len(n.Children))
+		for i := range n.Children {
+			gencodeUnsafeNodeFrom(&o.Children[i], &n.Children[i])
+		}
+	}
+}
+
+func gencodeUnsafeNodeTo(n *Node, o *GencodeUnsafeNode) {
+	*n = Node{Name: o.Name, Value: o.Value}
+	if len(o.Children) > 0 {
+		n.Children = make([]Node, len(o.Children))
+		for i := range o.Children {
+			gencodeUnsafeNodeTo(&n.Children[i], &o.Children[i])
+		}
+	}
+}
+
+var gencodeUnsafeNodeConverter = &Converter{
+	New:  func() interface{} { return &GencodeUnsafeNode{} },
+	From: func(dst, src interface{}) { gencodeUnsafeNodeFrom(dst.(*GencodeUnsafeNode), src.(*Node)) },
+	To:   func(dst, src interface{}) { gencodeUnsafeNodeTo(dst.(*Node), src.(*GencodeUnsafeNode)) },
+}
+
+// github.com/calmh/xdr
+
+func xdrNodeFrom(o *XDRNode, n *Node) {
+	*o = XDRNode{Name: n.Name, Value: n.Value}
+	if len(n.Children) > 0 {
+		o.Children = make([]XDRNode, len(n.Children))
+		for i := range n.Children {
+			xdrNodeFrom(&o.Children[i], &n.Children[i])
+		}
+	}
+}
+
+func xdrNodeTo(n *Node, o *XDRNode) {
+	*n = Node{Name: o.Name, Value: o.Value}
+	if len(o.Children) > 0 {
+		n.Children = make([]Node, len(o.Children))
+		for i := range o.Children {
+			xdrNodeTo(&n.Children[i], &o.Children[i])
+		}
+	}
+}
+
+var xdrNodeConverter = &Converter{
+	New:  func() interface{} { return &XDRNode{} },
+	From: func(dst, src interface{}) { xdrNodeFrom(dst.(*XDRNode), src.(*Node)) },
+	To:   func(dst, src interface{}) { xdrNodeTo(dst.(*Node), src.(*XDRNode)) },
+}
+
+// The Tree payload is registered for every serializer of A, but Gotiny and
+// ikeapack skip it: neither promises to handle recursive types, and a crash
+// would abort every test and benchmark. TestTreeDepth probes them in a
+// process of their own.
+func init() {
+	RegisterPayload(payloadTree, map[string]Adaptation{
+		"Gotiny": {
+			New:  func() Serializer { return NewGotinySerializer(Node{}) },
+			Skip: "gotiny does not promise to handle recursive types; see TestTreeDepth",
+		},
+		"Gob": {New: func() Serializer { return NewGobSerializer(Node{}) }},
+		"FlatBuffers": {Converter: flatBufferConverter(func() Serializer {
+			return &FlatBufferTreeSerializer{builder: flatbuffers.NewBuilder(0)}
+		})},
+		"Goprotobuf":    {Converter: protoBufNodeConverter},
+		"Gogoprotobuf":  {Converter: gogoProtoBufNodeConverter},
+		"Colfer":        {Converter: colferNodeConverter},
+		"Gencode":       {Converter: gencodeNodeConverter},
+		"GencodeUnsafe": {Converter: gencodeUnsafeNodeConverter},
+		"XDR2":          {Converter: xdrNodeConverter},
+		"Ikea":          {Skip: "ikeapack does not promise to handle recursive types; see TestTreeDepth"},
+	})
+}
@@ -0,0 +1,171 @@
+package goserbench
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Tolerance relaxes record validation for serializers that knowingly lose
+// precision. The zero value demands an exact match.
+type Tolerance struct {
+	// Time is the largest difference accepted between timestamps, e.g.
+	// time.Millisecond for formats storing milliseconds since the epoch.
+	Time time.Duration
+	// Float is the largest difference accepted between floating point
+	// values, relative to their magnitude when it exceeds one. It accounts
+	// for encoders printing floats with a limited number of digits.
+	Float float64
+}
+
+// FieldDiff is a single field whose decoded value differs from the original.
+type FieldDiff struct {
+	Field     string
+	Want, Got interface{}
+}
+
+func (d FieldDiff) String() string {
+	return fmt.Sprintf("%s: want %s, got %s", d.Field, short(d.Want), short(d.Got))
+}
+
+// FieldDiffs lists every mismatch found in a record.
+type FieldDiffs []FieldDiff
+
+func (d FieldDiffs) String() string {
+	lines := make([]string, len(d))
+	for i, f := range d {
+		lines[i] = "\t" + f.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// short formats v for a diff report, eliding the middle of long values such
+// as binary blobs.
+func short(v interface{}) string {
+	const max = 64
+	s := fmt.Sprintf("%#v", v)
+	if t, ok := v.(time.Time); ok {
+		s = t.Format(time.RFC3339Nano)
+	}
+	if len(s) > max {
+		s = fmt.Sprintf("%s...%s (%d chars)", s[:max/2], s[len(s)-max/2:], len(s))
+	}
+	return s
+}
+
+var (
+	timeType    = reflect.TypeOf(time.Time{})
+	tagsType    = reflect.TypeOf(map[string]string(nil))
+	aliasesType = reflect.TypeOf([]string(nil))
+)
+
+// Diff compares two records field by field, descending into nested structs,
+// pointers, slices and maps. Nil and empty slices or maps compare equal, as
+// most formats do not distinguish them on the wire.
+func Diff(want, got interface{}, tol Tolerance) FieldDiffs {
+	var d FieldDiffs
+	diffValues(&d, "", reflect.ValueOf(want), reflect.ValueOf(got), tol)
+	return d
+}
+
+func diffValues(d *FieldDiffs, path string, want, got reflect.Value, tol Tolerance) {
+	report := func() {
+		field := strings.TrimPrefix(path, ".")
+		if field == "" {
+			field = "value"
+		}
+		var w, g interface{}
+		if want.IsValid() && want.CanInterface() {
+			w = want.Interface()
+		}
+		if got.IsValid() && got.CanInterface() {
+			g = got.Interface()
+		}
+		*d = append(*d, FieldDiff{Field: field, Want: w, Got: g})
+	}
+	if !want.IsValid() || !got.IsValid() {
+		if want.IsValid() != got.IsValid() {
+			report()
+		}
+		return
+	}
+	if want.Type() != got.Type() {
+		report()
+		return
+	}
+	if want.Type() == timeType {
+		w, g := want.Interface().(time.Time), got.Interface().(time.Time)
+		delta := w.Sub(g)
+		if delta < 0 {
+			delta = -delta
+		}
+		if !w.Equal(g) && delta >= tol.Time {
+			report()
+		}
+		return
+	}
+	// The Tags and Aliases of TaggedA take a fast path when equal; otherwise
+	// every differing key or element is reported below.
+	switch want.Type() {
+	case tagsType:
+		if cmpTags(want.Interface().(map[string]string), got.Interface().(map[string]string)) {
+			return
+		}
+	case aliasesType:
+		if cmpAliases(want.Interface().([]string), got.Interface().([]string)) {
+			return
+		}
+	}
+	switch want.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if want.IsNil() || got.IsNil() {
+			if want.IsNil() != got.IsNil() {
+				report()
+			}
+			return
+		}
+		diffValues(d, path, want.Elem(), got.Elem(), tol)
+	case reflect.Struct:
+		t := want.Type()
+		for i := 0; i < t.NumField(); i++ {
+			if t.Field(i).PkgPath != "" {
+				continue
+			}
+			diffValues(d, path+"."+t.Field(i).Name, want.Field(i), got.Field(i), tol)
+		}
+	case reflect.Slice, reflect.Array:
+		if want.Type().Elem().Kind() == reflect.Uint8 && want.Kind() == reflect.Slice {
+			if !bytes.Equal(want.Bytes(), got.Bytes()) {
+				report()
+			}
+			return
+		}
+		if want.Len() != got.Len() {
+			report()
+			return
+		}
+		for i := 0; i < want.Len(); i++ {
+			diffValues(d, fmt.Sprintf("%s[%d]", path, i), want.Index(i), got.Index(i), tol)
+		}
+	case reflect.Map:
+		if want.Len() != got.Len() {
+			report()
+			return
+		}
+		for _, k := range want.MapKeys() {
+			diffValues(d, fmt.Sprintf("%s[%v]", path, k), want.MapIndex(k), got.MapIndex(k), tol)
+		}
+	case reflect.Float32, reflect.Float64:
+		w, g := want.Float(), got.Float()
+		if w != g && math.Abs(w-g) > tol.Float*math.Max(1, math.Abs(w)) {
+			report()
+		}
+	default:
+		if want.Interface() != got.Interface() {
+			report()
+		}
+	}
+}